@@ -28,6 +28,7 @@ import (
 
 	"github.com/karmada-io/dashboard/cmd/api/app/options"
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/audit"                    // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/auth"                     // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/cluster"                  // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/clusteroverridepolicy"    // Importing route packages forces route registration
@@ -51,9 +52,14 @@ import (
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/service"                  // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/statefulset"              // Importing route packages forces route registration
 	_ "github.com/karmada-io/dashboard/cmd/api/app/routes/unstructured"             // Importing route packages forces route registration
+	"github.com/karmada-io/dashboard/pkg/audit"
+	"github.com/karmada-io/dashboard/pkg/auth/csrf"
+	"github.com/karmada-io/dashboard/pkg/auth/oidc"
+	"github.com/karmada-io/dashboard/pkg/auth/session"
 	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/config"
 	"github.com/karmada-io/dashboard/pkg/environment"
+	"github.com/karmada-io/dashboard/pkg/serving"
 )
 
 // NewAPICommand creates a *cobra.Command object with default parameters
@@ -103,6 +109,9 @@ func run(ctx context.Context, opts *options.Options) error {
 		client.WithKubeconfig(opts.KarmadaKubeConfig),
 		client.WithKubeContext(opts.KarmadaContext),
 		client.WithInsecureTLSSkipVerify(opts.SkipKarmadaApiserverTLSVerify),
+		client.WithClientCacheTTL(opts.ClientCacheTTL),
+		client.WithServiceIdentityFallback(opts.EnableServiceIdentityFallback),
+		client.WithNamespaceTenancy(opts.EnableNamespaceTenancy),
 	)
 
 	client.InitKubeConfig(
@@ -112,13 +121,80 @@ func run(ctx context.Context, opts *options.Options) error {
 		client.WithInsecureTLSSkipVerify(opts.SkipKubeApiserverTLSVerify),
 	)
 	ensureAPIServerConnectionOrDie()
-	serve(opts)
-	config.InitDashboardConfig(client.InClusterClient(), ctx.Done())
+	if opts.BypassResourceCache {
+		klog.InfoS("Resource cache is bypassed, reads go to the karmada apiserver")
+	} else {
+		client.InitResourceCache(ctx.Done())
+	}
+	if err := oidc.InitProvider(oidc.Config{
+		IssuerURL:    opts.OIDCIssuerURL,
+		ClientID:     opts.OIDCClientID,
+		ClientSecret: opts.OIDCClientSecret,
+		RedirectURL:  opts.OIDCRedirectURL,
+		Scopes:       opts.OIDCScopes,
+		CAFile:       opts.OIDCCAFile,
+	}); err != nil {
+		return err
+	}
+	if err := initSessions(opts); err != nil {
+		return err
+	}
+	if err := initAudit(opts, ctx.Done()); err != nil {
+		return err
+	}
+	if opts.DisableCSRFProtection {
+		klog.Warning("CSRF protection is disabled")
+	} else {
+		csrf.Init(client.InClusterClient(), opts.Namespace)
+	}
+	if err := serve(opts, ctx.Done()); err != nil {
+		return err
+	}
+	configMapRef := config.ConfigMapRef{Namespace: opts.Namespace, Name: opts.ConfigName}
+	if err := config.MigrateRegistryCredentials(client.InClusterClient(), configMapRef); err != nil {
+		klog.ErrorS(err, "Failed to migrate registry credentials to Secrets")
+	}
+	config.InitDashboardConfig(client.InClusterClient(), configMapRef, ctx.Done())
 	<-ctx.Done()
 	os.Exit(0)
 	return nil
 }
 
+func initSessions(opts *options.Options) error {
+	switch opts.SessionStore {
+	case "none":
+		return nil
+	case "memory":
+		session.Init(session.NewMemoryStore(), opts.SessionTTL)
+	case "secret":
+		session.Init(session.NewSecretStore(client.InClusterClient(), opts.Namespace), opts.SessionTTL)
+	default:
+		return fmt.Errorf("unknown session store %q, must be one of memory, secret or none", opts.SessionStore)
+	}
+	client.SetTokenResolver(session.TokenFromRequest)
+	return nil
+}
+
+func initAudit(opts *options.Options, stopCh <-chan struct{}) error {
+	var sinks []audit.Sink
+	if opts.AuditLogPath != "" {
+		fileSink, err := audit.NewFileSink(opts.AuditLogPath)
+		if err != nil {
+			return fmt.Errorf("failed to open audit log: %w", err)
+		}
+		sinks = append(sinks, fileSink)
+	}
+	if opts.AuditWebhookURL != "" {
+		sinks = append(sinks, audit.NewWebhookSink(opts.AuditWebhookURL, stopCh))
+	}
+	var ringBuffer *audit.RingBuffer
+	if opts.AuditBufferSize > 0 {
+		ringBuffer = audit.NewRingBuffer(opts.AuditBufferSize)
+	}
+	audit.Init(ringBuffer, sinks...)
+	return nil
+}
+
 func ensureAPIServerConnectionOrDie() {
 	versionInfo, err := client.InClusterClient().Discovery().ServerVersion()
 	if err != nil {
@@ -137,10 +213,13 @@ func ensureAPIServerConnectionOrDie() {
 	klog.InfoS("Successful initial request to the Karmada apiserver", "version", karmadaVersionInfo.String())
 }
 
-func serve(opts *options.Options) {
-	insecureAddress := fmt.Sprintf("%s:%d", opts.InsecureBindAddress, opts.InsecurePort)
-	klog.V(1).InfoS("Listening and serving on", "address", insecureAddress)
-	go func() {
-		klog.Fatal(router.Router().Run(insecureAddress))
-	}()
+func serve(opts *options.Options, stopCh <-chan struct{}) error {
+	return serving.Serve(router.Handler(), serving.Config{
+		SecureAddress:   serving.Address(opts.BindAddress, opts.Port),
+		InsecureAddress: serving.Address(opts.InsecureBindAddress, opts.InsecurePort),
+		CertFile:        opts.TLSCertFile,
+		KeyFile:         opts.TLSKeyFile,
+		SelfSigned:      opts.TLSSelfSigned,
+		ClientCAFile:    opts.ClientCAFile,
+	}, stopCh)
 }
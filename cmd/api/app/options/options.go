@@ -18,8 +18,11 @@ package options
 
 import (
 	"net"
+	"time"
 
 	"github.com/spf13/pflag"
+
+	"github.com/karmada-io/dashboard/pkg/config"
 )
 
 // Options contains everything necessary to create and run api.
@@ -28,6 +31,10 @@ type Options struct {
 	Port                          int
 	InsecureBindAddress           net.IP
 	InsecurePort                  int
+	TLSCertFile                   string
+	TLSKeyFile                    string
+	TLSSelfSigned                 bool
+	ClientCAFile                  string
 	KubeConfig                    string
 	KubeContext                   string
 	SkipKubeApiserverTLSVerify    bool
@@ -35,8 +42,24 @@ type Options struct {
 	KarmadaContext                string
 	SkipKarmadaApiserverTLSVerify bool
 	Namespace                     string
+	ConfigName                    string
 	DisableCSRFProtection         bool
 	OpenAPIEnabled                bool
+	ClientCacheTTL                time.Duration
+	EnableServiceIdentityFallback bool
+	EnableNamespaceTenancy        bool
+	BypassResourceCache           bool
+	OIDCIssuerURL                 string
+	OIDCClientID                  string
+	OIDCClientSecret              string
+	OIDCRedirectURL               string
+	OIDCScopes                    []string
+	OIDCCAFile                    string
+	SessionStore                  string
+	SessionTTL                    time.Duration
+	AuditLogPath                  string
+	AuditBufferSize               int
+	AuditWebhookURL               string
 }
 
 // NewOptions returns initialized Options.
@@ -50,9 +73,13 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 		return
 	}
 	fs.IPVar(&o.BindAddress, "bind-address", net.IPv4(127, 0, 0, 1), "IP address on which to serve the --port, set to 0.0.0.0 for all interfaces")
-	fs.IntVar(&o.Port, "port", 8001, "secure port to listen to for incoming HTTPS requests")
+	fs.IntVar(&o.Port, "port", 8001, "secure port to listen to for incoming HTTPS requests, set to 0 to disable")
 	fs.IPVar(&o.InsecureBindAddress, "insecure-bind-address", net.IPv4(127, 0, 0, 1), "IP address on which to serve the --insecure-port, set to 0.0.0.0 for all interfaces")
-	fs.IntVar(&o.InsecurePort, "insecure-port", 8000, "port to listen to for incoming HTTP requests")
+	fs.IntVar(&o.InsecurePort, "insecure-port", 8000, "port to listen to for incoming HTTP requests, set to 0 to disable")
+	fs.StringVar(&o.TLSCertFile, "tls-cert-file", "", "file containing the x509 certificate for HTTPS, changes are reloaded. HTTPS is disabled if empty, unless --tls-self-signed is set")
+	fs.StringVar(&o.TLSKeyFile, "tls-private-key-file", "", "file containing the x509 private key matching --tls-cert-file")
+	fs.BoolVar(&o.TLSSelfSigned, "tls-self-signed", false, "serve HTTPS with a generated self-signed certificate if --tls-cert-file is empty, only meant for development")
+	fs.StringVar(&o.ClientCAFile, "client-ca-file", "", "if set, HTTPS clients must present a certificate signed by one of the authorities in this file")
 	fs.StringVar(&o.KubeConfig, "kubeconfig", "", "Path to the host cluster kubeconfig file.")
 	fs.StringVar(&o.KubeContext, "context", "", "The name of the kubeconfig context to use.")
 	fs.BoolVar(&o.SkipKubeApiserverTLSVerify, "skip-kube-apiserver-tls-verify", false, "enable if connection with remote Kubernetes API server should skip TLS verify")
@@ -60,6 +87,22 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.KarmadaContext, "karmada-context", "", "The name of the karmada-kubeconfig context to use.")
 	fs.BoolVar(&o.SkipKarmadaApiserverTLSVerify, "skip-karmada-apiserver-tls-verify", false, "enable if connection with remote Karmada API server should skip TLS verify")
 	fs.StringVar(&o.Namespace, "namespace", "karmada-dashboard", "Namespace to use when accessing Dashboard specific resources, i.e. configmap")
+	fs.StringVar(&o.ConfigName, "config-name", config.DefaultConfigName, "name of the dashboard configmap in --namespace")
 	fs.BoolVar(&o.DisableCSRFProtection, "disable-csrf-protection", false, "allows disabling CSRF protection")
 	fs.BoolVar(&o.OpenAPIEnabled, "openapi-enabled", false, "enables OpenAPI v2 endpoint under '/apidocs.json'")
+	fs.DurationVar(&o.ClientCacheTTL, "client-cache-ttl", 10*time.Minute, "how long karmada apiserver clients built for a user token are cached")
+	fs.StringVar(&o.OIDCIssuerURL, "oidc-issuer-url", "", "URL of the OpenID Connect provider used for dashboard login, OIDC login is disabled if empty")
+	fs.StringVar(&o.OIDCClientID, "oidc-client-id", "", "OAuth2 client id of the dashboard at the OpenID Connect provider")
+	fs.StringVar(&o.OIDCClientSecret, "oidc-client-secret", "", "OAuth2 client secret of the dashboard, leave empty for public clients")
+	fs.StringVar(&o.OIDCRedirectURL, "oidc-redirect-url", "", "callback URL of the dashboard registered at the OpenID Connect provider")
+	fs.StringSliceVar(&o.OIDCScopes, "oidc-scopes", []string{"profile", "email", "offline_access"}, "scopes requested in addition to openid")
+	fs.StringVar(&o.OIDCCAFile, "oidc-ca-file", "", "path to the CA bundle used to verify the OpenID Connect provider, the system roots are used if empty")
+	fs.StringVar(&o.SessionStore, "session-store", "memory", "where login sessions are kept, one of memory, secret (Secrets in --namespace of the host cluster) or none to hand the token to the browser")
+	fs.DurationVar(&o.SessionTTL, "session-ttl", 12*time.Hour, "lifetime of a login session")
+	fs.StringVar(&o.AuditLogPath, "audit-log-path", "", "file the audit events of mutating requests are appended to as JSON lines, disabled if empty")
+	fs.IntVar(&o.AuditBufferSize, "audit-buffer-size", 1000, "number of recent audit events kept in memory and served by /api/v1/audit, 0 disables the buffer")
+	fs.StringVar(&o.AuditWebhookURL, "audit-webhook-url", "", "URL audit events are posted to as JSON, disabled if empty")
+	fs.BoolVar(&o.EnableServiceIdentityFallback, "enable-service-identity-fallback", false, "serve requests without a bearer token with the dashboard's own karmada identity, only for trusted single-user installs")
+	fs.BoolVar(&o.EnableNamespaceTenancy, "enable-namespace-tenancy", false, "limit namespaced lists, counts and the namespace list to the namespaces each user is allowed to list resources in")
+	fs.BoolVar(&o.BypassResourceCache, "bypass-resource-cache", false, "read lists, details and the overview directly from the karmada apiserver instead of the shared informer cache")
 }
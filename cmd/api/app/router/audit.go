@@ -0,0 +1,124 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/karmada-io/dashboard/pkg/audit"
+	"github.com/karmada-io/dashboard/pkg/client"
+)
+
+var auditVerbs = map[string]string{
+	http.MethodPost:   "create",
+	http.MethodPut:    "update",
+	http.MethodPatch:  "patch",
+	http.MethodDelete: "delete",
+}
+
+// auditObjectKey is the key of the gin context under which handlers leave the object a request acts on.
+const auditObjectKey = "karmada-dashboard/audit-object"
+
+// auditNameParams are the route params which name the object of a request, in order of precedence.
+var auditNameParams = []string{"name", "deployment", "statefulset", "service", "cluster", "override", "propagation", "handle"}
+
+type auditObject struct {
+	namespace string
+	name      string
+}
+
+// SetAuditObject records the namespace and name of the object a request acts on for its audit event.
+// Handlers which read them from the request body call it, otherwise the route params are used.
+// Empty values keep the ones of the route.
+func SetAuditObject(c *gin.Context, namespace, name string) {
+	c.Set(auditObjectKey, auditObject{namespace: namespace, name: name})
+}
+
+func routeObjectName(c *gin.Context) string {
+	for _, param := range auditNameParams {
+		if name := c.Param(param); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// AuditMiddleware records every mutating request with its outcome to the audit sinks.
+func AuditMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		verb, mutating := auditVerbs[c.Request.Method]
+		if !mutating || !audit.Enabled() {
+			c.Next()
+			return
+		}
+
+		start := time.Now()
+		event := &audit.Event{
+			Timestamp: start,
+			Verb:      verb,
+			Method:    c.Request.Method,
+			Path:      c.Request.URL.Path,
+			SourceIP:  c.ClientIP(),
+			Cluster:   c.Param("clustername"),
+			Resource:  requestResource(c),
+			Namespace: c.Param("namespace"),
+			Name:      routeObjectName(c),
+		}
+		if c.Request.Body != nil {
+			body, err := io.ReadAll(c.Request.Body)
+			if err == nil && len(body) > 0 {
+				sum := sha256.Sum256(body)
+				event.RequestDigest = hex.EncodeToString(sum[:])
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		c.Next()
+
+		if value, ok := c.Get(auditObjectKey); ok {
+			object := value.(auditObject)
+			if object.namespace != "" {
+				event.Namespace = object.namespace
+			}
+			if object.name != "" {
+				event.Name = object.name
+			}
+		}
+		event.LatencyMs = time.Since(start).Milliseconds()
+		event.Result = audit.ResultSuccess
+		if err := c.Errors.Last(); err != nil {
+			event.Result = audit.ResultFailure
+			event.Message = err.Error()
+		} else if c.Writer.Status() >= http.StatusBadRequest {
+			event.Result = audit.ResultFailure
+			event.Message = http.StatusText(c.Writer.Status())
+		}
+		// resolved after the handler, login handlers put the submitted token on the request
+		if userInfo, err := client.GetUserInfo(c.Request); err == nil {
+			event.User = userInfo.Username
+			event.Groups = userInfo.Groups
+		}
+		audit.Record(event)
+	}
+}
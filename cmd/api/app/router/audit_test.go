@@ -0,0 +1,67 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/karmada-io/dashboard/pkg/audit"
+)
+
+func TestAuditMiddlewareObjectName(t *testing.T) {
+	ringBuffer := audit.NewRingBuffer(10)
+	audit.Init(ringBuffer)
+	t.Cleanup(func() { audit.Init(nil) })
+
+	engine := gin.New()
+	api := engine.Group("/api/v1", AuditMiddleware())
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	api.DELETE("/cluster/:name", ok)
+	api.PUT("/service/:namespace/:service", ok)
+	api.DELETE("/propagationpolicy", func(c *gin.Context) {
+		SetAuditObject(c, "default", "nginx-propagation")
+		c.Status(http.StatusOK)
+	})
+
+	expected := map[string][2]string{
+		"/api/v1/cluster/member1":       {"", "member1"},
+		"/api/v1/service/default/nginx": {"default", "nginx"},
+		"/api/v1/propagationpolicy":     {"default", "nginx-propagation"},
+	}
+	for path := range expected {
+		method := http.MethodDelete
+		if strings.HasPrefix(path, "/api/v1/service") {
+			method = http.MethodPut
+		}
+		engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(method, path, strings.NewReader(`{}`)))
+	}
+
+	events := ringBuffer.List()
+	if len(events) != len(expected) {
+		t.Fatalf("expected %d audit events, got %d", len(expected), len(events))
+	}
+	for _, event := range events {
+		if object := expected[event.Path]; event.Namespace != object[0] || event.Name != object[1] {
+			t.Errorf("expected %s to be recorded for %s/%s, got %s/%s", event.Path, object[0], object[1], event.Namespace, event.Name)
+		}
+	}
+}
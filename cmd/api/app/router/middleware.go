@@ -19,26 +19,89 @@ package router
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/auth/csrf"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
 )
 
 // EnsureMemberClusterMiddleware ensures that the member cluster exists.
 func EnsureMemberClusterMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		karmadaClient := client.InClusterKarmadaClient()
-		_, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), c.Param("clustername"), metav1.GetOptions{})
+		karmadaClient, err := client.GetKarmadaClientFromRequest(c.Request)
 		if err != nil {
-			c.AbortWithStatusJSON(http.StatusOK, common.BaseResponse{
-				Code: 500,
-				Msg:  err.Error(),
-			})
+			c.Abort()
+			common.Fail(c, err)
+			return
+		}
+		_, err = karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), c.Param("clustername"), metav1.GetOptions{})
+		if err != nil {
+			c.Abort()
+			common.Fail(c, err)
+			return
+		}
+		c.Next()
+	}
+}
+
+// CacheFreshnessMiddleware lets the cached clients of the request record how fresh the data they read
+// is, common.Response reports it.
+func CacheFreshnessMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(client.WithCacheFreshness(c.Request.Context()))
+		c.Next()
+	}
+}
+
+// csrfExemptRoutes establish the session csrf tokens are bound to, so clients can not hold a token
+// when calling them.
+var csrfExemptRoutes = map[string]bool{
+	"/api/v1/login":               true,
+	"/api/v1/login/oidc/callback": true,
+}
+
+// CSRFMiddleware rejects mutating requests without a valid csrf token, unless csrf protection is disabled.
+func CSRFMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !csrf.Enabled() || !isMutating(c.Request.Method) || csrfExemptRoutes[c.FullPath()] {
+			c.Next()
+			return
+		}
+		if !csrf.Valid(c.Request) {
+			c.Abort()
+			common.Fail(c, errors.NewCSRFValidationError())
 			return
 		}
 		c.Next()
 	}
 }
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// requestResource returns the kind of raw resource requests, or the first path segment of the route
+// below /api/v1 and /api/v1/member/:clustername.
+func requestResource(c *gin.Context) string {
+	if kind := c.Param("kind"); kind != "" {
+		return kind
+	}
+	path := c.FullPath()
+	if path == "" {
+		path = c.Request.URL.Path
+	}
+	path = strings.TrimPrefix(path, "/api/v1")
+	path = strings.TrimPrefix(path, "/member/:clustername")
+	segment, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	return segment
+}
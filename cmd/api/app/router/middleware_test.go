@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/auth/csrf"
+)
+
+func TestCSRFMiddleware(t *testing.T) {
+	csrf.Init(fake.NewSimpleClientset(), "karmada-system")
+
+	engine := gin.New()
+	api := engine.Group("/api/v1", CSRFMiddleware())
+	for _, path := range []string{"/config", "/login", "/login/oidc/callback"} {
+		api.Handle(http.MethodGet, path, func(c *gin.Context) { c.Status(http.StatusOK) })
+		api.Handle(http.MethodPost, path, func(c *gin.Context) { c.Status(http.StatusOK) })
+	}
+	token := csrf.Generate(httptest.NewRequest(http.MethodGet, "/api/v1/csrftoken", nil))
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		status int
+	}{
+		{name: "safe methods need no token", method: http.MethodGet, path: "/api/v1/config", status: http.StatusOK},
+		{name: "mutations without a token are rejected", method: http.MethodPost, path: "/api/v1/config", status: http.StatusForbidden},
+		{name: "mutations with a wrong token are rejected", method: http.MethodPost, path: "/api/v1/config", token: "wrong", status: http.StatusForbidden},
+		{name: "mutations with a token are accepted", method: http.MethodPost, path: "/api/v1/config", token: token, status: http.StatusOK},
+		{name: "login is exempt", method: http.MethodPost, path: "/api/v1/login", status: http.StatusOK},
+		{name: "oidc callback is exempt", method: http.MethodPost, path: "/api/v1/login/oidc/callback", status: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set(csrf.HeaderName, tt.token)
+			}
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, req)
+			// v1 errors are wrapped in a 200 envelope carrying the status
+			status := w.Code
+			var response common.BaseResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err == nil && response.Error != nil {
+				status = response.Error.Status
+			}
+			if status != tt.status {
+				t.Errorf("expected status %d, got %d: %s", tt.status, status, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/auth/rbac"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/config"
+)
+
+// unrestrictedResources are available to every user, they deal with the user's own login.
+var unrestrictedResources = map[string]bool{
+	"login":     true,
+	"logout":    true,
+	"me":        true,
+	"csrftoken": true,
+	"sessions":  true,
+}
+
+// RBACMiddleware rejects requests which none of the dashboard roles of the user allows.
+func RBACMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		resource := requestResource(c)
+		// the config handler filters the menus by role itself
+		if unrestrictedResources[resource] || (resource == "config" && c.Request.Method == http.MethodGet) {
+			c.Next()
+			return
+		}
+		authorizer := rbac.NewAuthorizer(config.GetDashboardConfig())
+		if authorizer == nil {
+			c.Next()
+			return
+		}
+		userInfo, err := client.GetUserInfo(c.Request)
+		if err != nil {
+			c.Abort()
+			_, err = errors.HandleError(err)
+			common.Fail(c, err)
+			return
+		}
+		verb := rbac.VerbRead
+		if isMutating(c.Request.Method) {
+			verb = rbac.VerbWrite
+		}
+		if !authorizer.Allowed(authorizer.RolesFor(userInfo.Username, userInfo.Groups), verb, resource) {
+			c.Abort()
+			common.Fail(c, errors.NewForbidden(resource, fmt.Errorf("user %q may not %s %s", userInfo.Username, verb, resource)))
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireAdmin returns a forbidden error unless one of the dashboard roles of the user of the request
+// allows everything. Without role bindings nobody is an admin, so administering the dashboard is not
+// open to every authenticated user of installs which do not use dashboard RBAC.
+func RequireAdmin(request *http.Request) error {
+	authorizer := rbac.NewAuthorizer(config.GetDashboardConfig())
+	if authorizer == nil {
+		return errors.NewForbidden(rbac.RoleAdmin, fmt.Errorf("no dashboard role bindings are configured, bind the %s role to a user first", rbac.RoleAdmin))
+	}
+	userInfo, err := client.GetUserInfo(request)
+	if err != nil {
+		_, err = errors.HandleError(err)
+		return err
+	}
+	if !authorizer.Allowed(authorizer.RolesFor(userInfo.Username, userInfo.Groups), rbac.All, rbac.All) {
+		return errors.NewForbidden(rbac.RoleAdmin, fmt.Errorf("user %q is not a dashboard admin", userInfo.Username))
+	}
+	return nil
+}
@@ -17,8 +17,13 @@ limitations under the License.
 package router
 
 import (
+	"net/http"
+	"strings"
+
 	"github.com/gin-gonic/gin"
 
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/environment"
 )
 
@@ -36,6 +41,7 @@ func init() {
 	router = gin.Default()
 	_ = router.SetTrustedProxies(nil)
 	v1 = router.Group("/api/v1")
+	v1.Use(AuditMiddleware(), CSRFMiddleware(), RBACMiddleware(), CacheFreshnessMiddleware())
 	member = v1.Group("/member/:clustername")
 	member.Use(EnsureMemberClusterMiddleware())
 
@@ -43,6 +49,10 @@ func init() {
 		c.String(200, "livez")
 	})
 	router.GET("/readyz", func(c *gin.Context) {
+		if !client.ResourceCacheSynced() {
+			c.String(http.StatusServiceUnavailable, "resource cache not synced")
+			return
+		}
 		c.String(200, "readyz")
 	})
 }
@@ -57,6 +67,22 @@ func Router() *gin.Engine {
 	return router
 }
 
+// Handler serves the router. Requests to /api/v2 are served by the /api/v1 routes with v2 responses, see
+// common.IsV2.
+func Handler() http.Handler {
+	handler := router.Handler()
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if rest, ok := strings.CutPrefix(req.URL.Path, "/api/v2"); ok && (rest == "" || strings.HasPrefix(rest, "/")) {
+			req = common.WithV2(req)
+			u := *req.URL
+			u.Path = "/api/v1" + rest
+			u.RawPath = ""
+			req.URL = &u
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
 // MemberV1 returns the router group for /api/v1/member/:clustername which for resources in specific member cluster.
 func MemberV1() *gin.RouterGroup {
 	return member
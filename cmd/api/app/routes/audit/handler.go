@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/router"
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/audit"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+func handleGetAuditEvents(c *gin.Context) {
+	// the audit log shows the actions of all users, only admins may read it
+	if err := router.RequireAdmin(c.Request); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	ringBuffer := audit.Buffer()
+	if ringBuffer == nil {
+		common.Fail(c, errors.NewNotFound("the audit buffer is disabled"))
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, audit.GetEventList(ringBuffer, dataSelect))
+}
+
+func init() {
+	r := router.V1()
+	r.GET("/audit", handleGetAuditEvents)
+}
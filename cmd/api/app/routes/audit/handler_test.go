@@ -0,0 +1,127 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	authenticationv1 "k8s.io/api/authentication/v1"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/audit"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/config"
+)
+
+// initKarmadaAPIServer points the client package at a fake karmada apiserver which authenticates
+// bearer tokens as the user of the same name.
+func initKarmadaAPIServer(t *testing.T) {
+	// credentials are only sent to https servers
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/authentication.k8s.io/v1/selfsubjectreviews" {
+			http.NotFound(w, r)
+			return
+		}
+		review := authenticationv1.SelfSubjectReview{}
+		review.APIVersion, review.Kind = "authentication.k8s.io/v1", "SelfSubjectReview"
+		review.Status.UserInfo.Username = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(review)
+	}))
+	t.Cleanup(server.Close)
+
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	content := `apiVersion: v1
+kind: Config
+clusters:
+- name: karmada
+  cluster:
+    server: ` + server.URL + `
+users:
+- name: dashboard
+  user:
+    token: dashboard
+contexts:
+- name: karmada
+  context:
+    cluster: karmada
+    user: dashboard
+current-context: karmada
+`
+	if err := os.WriteFile(kubeconfig, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	client.InitKarmadaConfig(client.WithKubeconfig(kubeconfig), client.WithInsecureTLSSkipVerify(true))
+}
+
+func initDashboardConfig(t *testing.T, content string) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.InitDashboardConfigFromMountFile(path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHandleGetAuditEventsRequiresAdmin(t *testing.T) {
+	initKarmadaAPIServer(t)
+	audit.Init(audit.NewRingBuffer(10))
+
+	getAuditEvents := func(token string) int {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/audit", nil)
+		client.SetAuthorizationHeader(c.Request, token)
+		handleGetAuditEvents(c)
+
+		var response common.BaseResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatal(err)
+		}
+		if response.Error != nil {
+			return response.Error.Status
+		}
+		return http.StatusOK
+	}
+
+	// without role bindings nobody is an admin
+	initDashboardConfig(t, "role_bindings: []\n")
+	if status := getAuditEvents("alice"); status != http.StatusForbidden {
+		t.Errorf("expected the audit log to be forbidden without role bindings, got %d", status)
+	}
+
+	initDashboardConfig(t, `role_bindings:
+- role: admin
+  users: [alice]
+- role: viewer
+  users: [bob]
+`)
+	if status := getAuditEvents("bob"); status != http.StatusForbidden {
+		t.Errorf("expected a viewer to be forbidden to read the audit log, got %d", status)
+	}
+	if status := getAuditEvents("alice"); status != http.StatusOK {
+		t.Errorf("expected an admin to read the audit log, got %d", status)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/auth/csrf"
 )
 
 func handleLogin(c *gin.Context) {
@@ -37,6 +38,11 @@ func handleLogin(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	if err = startSession(c, response); err != nil {
+		klog.ErrorS(err, "Could not create session")
+		common.Fail(c, err)
+		return
+	}
 	common.Success(c, response)
 }
 
@@ -51,7 +57,93 @@ func handleMe(c *gin.Context) {
 	common.Success(c, response)
 }
 
+func handleOIDCLogin(c *gin.Context) {
+	response, _, err := oidcLogin(c.Writer, c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Could not start oidc login")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, response)
+}
+
+func handleOIDCCallback(c *gin.Context) {
+	callbackRequest := new(v1.OIDCCallbackRequest)
+	if err := c.ShouldBind(callbackRequest); err != nil {
+		klog.ErrorS(err, "Could not read oidc callback request")
+		common.Fail(c, err)
+		return
+	}
+	response, _, err := oidcCallback(c.Writer, callbackRequest, c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Could not finish oidc login")
+		common.Fail(c, err)
+		return
+	}
+	if err = startSession(c, response); err != nil {
+		klog.ErrorS(err, "Could not create session")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, response)
+}
+
+func handleOIDCRefresh(c *gin.Context) {
+	refreshRequest := new(v1.OIDCRefreshRequest)
+	if err := c.ShouldBind(refreshRequest); err != nil {
+		klog.ErrorS(err, "Could not read oidc refresh request")
+		common.Fail(c, err)
+		return
+	}
+	response, _, err := oidcRefresh(refreshRequest, c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Could not refresh oidc token")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, response)
+}
+
+func handleLogout(c *gin.Context) {
+	if _, err := logout(c); err != nil {
+		klog.ErrorS(err, "Could not revoke session")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleListSessions(c *gin.Context) {
+	response, _, err := listSessions(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Could not list sessions")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, response)
+}
+
+func handleRevokeSession(c *gin.Context) {
+	if _, err := revokeSession(c.Request, c.Param("handle")); err != nil {
+		klog.ErrorS(err, "Could not revoke session")
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+func handleCSRFToken(c *gin.Context) {
+	common.Success(c, &v1.CSRFTokenResponse{Token: csrf.Generate(c.Request)})
+}
+
 func init() {
 	router.V1().POST("/login", handleLogin)
 	router.V1().GET("/me", handleMe)
+	router.V1().GET("/csrftoken", handleCSRFToken)
+	router.V1().GET("/login/oidc", handleOIDCLogin)
+	router.V1().POST("/login/oidc/callback", handleOIDCCallback)
+	router.V1().POST("/login/oidc/refresh", handleOIDCRefresh)
+	router.V1().POST("/logout", handleLogout)
+	router.V1().GET("/sessions", handleListSessions)
+	router.V1().DELETE("/sessions/:handle", handleRevokeSession)
 }
@@ -24,14 +24,19 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/pkg/auth/rbac"
 	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/config"
 )
 
 const (
 	tokenServiceAccountKey = "serviceaccount"
 )
 
+// oidcNameClaims are the id token claims used as user name, in order of preference.
+var oidcNameClaims = []string{"preferred_username", "email", "name", "sub"}
+
 func me(request *http.Request) (*v1.User, int, error) {
 	karmadaClient, err := client.GetKarmadaClientFromRequest(request)
 	if err != nil {
@@ -45,7 +50,16 @@ func me(request *http.Request) (*v1.User, int, error) {
 		return nil, code, err
 	}
 
-	return getUserFromToken(client.GetBearerToken(request)), http.StatusOK, nil
+	user := getUserFromToken(client.GetBearerToken(request))
+	if authorizer := rbac.NewAuthorizer(config.GetDashboardConfig()); authorizer != nil {
+		userInfo, err := client.GetUserInfo(request)
+		if err != nil {
+			code, err := errors.HandleError(err)
+			return nil, code, err
+		}
+		user.Roles = authorizer.RolesFor(userInfo.Username, userInfo.Groups)
+	}
+	return user, http.StatusOK, nil
 }
 
 func getUserFromToken(token string) *v1.User {
@@ -58,7 +72,7 @@ func getUserFromToken(token string) *v1.User {
 
 	found, value := traverse(tokenServiceAccountKey, claims)
 	if !found {
-		return &v1.User{Authenticated: true}
+		return getUserFromOIDCClaims(claims)
 	}
 
 	var sa v1.ServiceAccount
@@ -70,6 +84,15 @@ func getUserFromToken(token string) *v1.User {
 	return &v1.User{Name: sa.Name, Authenticated: true}
 }
 
+func getUserFromOIDCClaims(claims jwt.MapClaims) *v1.User {
+	for _, claim := range oidcNameClaims {
+		if name, ok := claims[claim].(string); ok && name != "" {
+			return &v1.User{Name: name, Authenticated: true}
+		}
+	}
+	return &v1.User{Authenticated: true}
+}
+
 func traverse(key string, m map[string]interface{}) (found bool, value interface{}) {
 	for k, v := range m {
 		if k == key {
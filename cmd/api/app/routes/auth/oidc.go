@@ -0,0 +1,104 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"net/http"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/pkg/auth/oidc"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+func oidcLogin(w http.ResponseWriter, request *http.Request) (*v1.OIDCLoginResponse, int, error) {
+	provider, err := oidc.GetProvider()
+	if err != nil {
+		return nil, http.StatusNotFound, errors.NewNotFound(err.Error())
+	}
+	authCodeURL, state, err := provider.AuthCodeURL(request.Context())
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	oidc.SetStateCookie(w, request, state)
+	return &v1.OIDCLoginResponse{AuthCodeURL: authCodeURL}, http.StatusOK, nil
+}
+
+// oidcCallback finishes the login, only in the browser which started it.
+func oidcCallback(w http.ResponseWriter, spec *v1.OIDCCallbackRequest, request *http.Request) (*v1.LoginResponse, int, error) {
+	provider, err := oidc.GetProvider()
+	if err != nil {
+		return nil, http.StatusNotFound, errors.NewNotFound(err.Error())
+	}
+	if err = oidc.VerifyStateCookie(request, spec.State); err != nil {
+		return nil, http.StatusUnauthorized, errors.NewUnauthorized(err.Error())
+	}
+	oidc.ClearStateCookie(w, request)
+	token, err := provider.Exchange(request.Context(), spec.Code, spec.State)
+	if err != nil {
+		return nil, http.StatusUnauthorized, errors.NewUnauthorized(err.Error())
+	}
+	return toLoginResponse(token, request)
+}
+
+func oidcRefresh(spec *v1.OIDCRefreshRequest, request *http.Request) (*v1.LoginResponse, int, error) {
+	provider, err := oidc.GetProvider()
+	if err != nil {
+		return nil, http.StatusNotFound, errors.NewNotFound(err.Error())
+	}
+	if spec.RefreshToken == "" {
+		return refreshSession(request)
+	}
+	token, err := provider.Refresh(request.Context(), spec.RefreshToken)
+	if err != nil {
+		return nil, http.StatusUnauthorized, errors.NewTokenExpired(errors.MsgTokenExpiredError)
+	}
+	return toLoginResponse(token, request)
+}
+
+// refreshSession refreshes the id token kept in the session of the request.
+func refreshSession(request *http.Request) (*v1.LoginResponse, int, error) {
+	manager, s, code, err := currentSession(request)
+	if err != nil {
+		return nil, code, err
+	}
+	if s.RefreshToken == "" {
+		return nil, http.StatusBadRequest, errors.NewBadRequest("session has no refresh token")
+	}
+	if err = manager.Refresh(request.Context(), s); err != nil {
+		return nil, http.StatusUnauthorized, errors.NewTokenExpired(errors.MsgTokenExpiredError)
+	}
+	return &v1.LoginResponse{ExpiresAt: s.TokenExpiresAt.Unix()}, http.StatusOK, nil
+}
+
+// toLoginResponse makes sure that the karmada apiserver accepts the id token before handing it out.
+func toLoginResponse(token *oidc.Token, request *http.Request) (*v1.LoginResponse, int, error) {
+	client.SetAuthorizationHeader(request, token.IDToken)
+	karmadaClient, err := client.GetKarmadaClientFromRequest(request)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if _, err = karmadaClient.Discovery().ServerVersion(); err != nil {
+		code, err := errors.HandleError(err)
+		return nil, code, err
+	}
+	return &v1.LoginResponse{
+		Token:        token.IDToken,
+		RefreshToken: token.RefreshToken,
+		ExpiresAt:    token.Expiry.Unix(),
+	}, http.StatusOK, nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	stderrors "errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
+	"github.com/karmada-io/dashboard/pkg/auth/session"
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+// startSession keeps the credentials of the login response on the server side and hands out a
+// session cookie instead. Without a session manager the response is left untouched.
+func startSession(c *gin.Context, response *v1.LoginResponse) error {
+	manager := session.GetManager()
+	if manager == nil {
+		return nil
+	}
+	var tokenExpiresAt time.Time
+	if response.ExpiresAt > 0 {
+		tokenExpiresAt = time.Unix(response.ExpiresAt, 0)
+	}
+	user, err := authenticatedUser(c.Request, response.Token)
+	if err != nil {
+		return err
+	}
+	s, err := manager.Create(c.Request.Context(), user, response.Token, response.RefreshToken, tokenExpiresAt)
+	if err != nil {
+		return err
+	}
+	session.SetCookie(c.Writer, c.Request, s)
+	response.Token = ""
+	response.RefreshToken = ""
+	return nil
+}
+
+// authenticatedUser returns the name the karmada apiserver authenticates the token as. The owner of a
+// session decides who may list and revoke it, so the unverified claims of the token must not be used.
+func authenticatedUser(request *http.Request, token string) (string, error) {
+	// a request of its own, the impersonation headers of the login request must not apply
+	identity, err := http.NewRequestWithContext(request.Context(), http.MethodGet, "/", nil)
+	if err != nil {
+		return "", err
+	}
+	client.SetAuthorizationHeader(identity, token)
+	userInfo, err := client.GetUserInfo(identity)
+	if err != nil {
+		return "", err
+	}
+	return userInfo.Username, nil
+}
+
+func currentSession(request *http.Request) (*session.Manager, *session.Session, int, error) {
+	manager := session.GetManager()
+	if manager == nil {
+		return nil, nil, http.StatusNotFound, errors.NewNotFound("server-side sessions are disabled")
+	}
+	s, err := manager.FromRequest(request)
+	if err != nil {
+		return nil, nil, http.StatusUnauthorized, errors.NewUnauthorized(errors.MsgLoginUnauthorizedError)
+	}
+	return manager, s, http.StatusOK, nil
+}
+
+func logout(c *gin.Context) (int, error) {
+	defer session.ClearCookie(c.Writer, c.Request)
+	manager, s, code, err := currentSession(c.Request)
+	if err != nil {
+		return code, err
+	}
+	if err = manager.Revoke(c.Request.Context(), s.ID); err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
+
+func listSessions(request *http.Request) ([]v1.SessionInfo, int, error) {
+	manager, current, code, err := currentSession(request)
+	if err != nil {
+		return nil, code, err
+	}
+	sessions, err := manager.ListForUser(request.Context(), current.User)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	result := make([]v1.SessionInfo, 0, len(sessions))
+	for _, s := range sessions {
+		// sessions without a user name can not be told apart, only show the current one
+		if current.User == "" && s.ID != current.ID {
+			continue
+		}
+		result = append(result, v1.SessionInfo{
+			Handle:    s.Handle(),
+			CreatedAt: s.CreatedAt,
+			ExpiresAt: s.ExpiresAt,
+			Current:   s.ID == current.ID,
+		})
+	}
+	return result, http.StatusOK, nil
+}
+
+func revokeSession(request *http.Request, handle string) (int, error) {
+	manager, current, code, err := currentSession(request)
+	if err != nil {
+		return code, err
+	}
+	if current.User == "" && current.Handle() != handle {
+		return http.StatusNotFound, errors.NewNotFound("session not found")
+	}
+	err = manager.RevokeForUser(request.Context(), current.User, handle)
+	if stderrors.Is(err, session.ErrNotFound) {
+		return http.StatusNotFound, errors.NewNotFound("session not found")
+	}
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	return http.StatusOK, nil
+}
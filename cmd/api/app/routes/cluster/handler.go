@@ -37,8 +37,26 @@ import (
 )
 
 func handleGetClusterList(c *gin.Context) {
-	karmadaClient := client.InClusterKarmadaClient()
-	dataSelect := common.ParseDataSelectPathParameter(c)
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if common.IsWatch(c) {
+		watcher, err := cluster.WatchClusterList(karmadaClient, dataSelect, common.ParseResourceVersion(c))
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		common.StreamWatch(c, watcher)
+		return
+	}
 	result, err := cluster.GetClusterList(karmadaClient, dataSelect)
 	if err != nil {
 		klog.ErrorS(err, "GetClusterList failed")
@@ -49,7 +67,12 @@ func handleGetClusterList(c *gin.Context) {
 }
 
 func handleGetClusterDetail(c *gin.Context) {
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	name := c.Param("name")
 	result, err := cluster.GetClusterDetail(karmadaClient, name)
 	if err != nil {
@@ -67,6 +90,7 @@ func handlePostCluster(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	router.SetAuditObject(c, "", clusterRequest.MemberClusterName)
 	memberClusterEndpoint, err := parseEndpointFromKubeconfig(clusterRequest.MemberClusterKubeConfig)
 	if err != nil {
 		klog.ErrorS(err, "Could not parse member cluster endpoint")
@@ -74,7 +98,12 @@ func handlePostCluster(c *gin.Context) {
 		return
 	}
 	clusterRequest.MemberClusterEndpoint = memberClusterEndpoint
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 
 	if clusterRequest.SyncMode == v1alpha1.Pull {
 		memberClusterClient, err := client.KubeClientSetFromKubeConfig(clusterRequest.MemberClusterKubeConfig)
@@ -83,7 +112,7 @@ func handlePostCluster(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		_, apiConfig, err := client.GetKarmadaConfig()
+		_, apiConfig, err := client.GetKarmadaConfigFromRequest(c.Request)
 		if err != nil {
 			klog.ErrorS(err, "Get apiConfig for karmada failed")
 			common.Fail(c, err)
@@ -111,7 +140,7 @@ func handlePostCluster(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
-		restConfig, _, err := client.GetKarmadaConfig()
+		restConfig, _, err := client.GetKarmadaConfigFromRequest(c.Request)
 		if err != nil {
 			klog.ErrorS(err, "Get restConfig failed")
 			common.Fail(c, err)
@@ -144,7 +173,12 @@ func handlePutCluster(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	memberCluster, err := karmadaClient.ClusterV1alpha1().Clusters().Get(context.TODO(), name, metav1.GetOptions{})
 	if err != nil {
 		klog.ErrorS(err, "Get cluster failed")
@@ -190,10 +224,15 @@ func handleDeleteCluster(c *gin.Context) {
 		return
 	}
 	clusterName := clusterRequest.MemberClusterName
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	waitDuration := time.Second * 60
 
-	err := karmadaClient.ClusterV1alpha1().Clusters().Delete(ctx, clusterName, metav1.DeleteOptions{})
+	err = karmadaClient.ClusterV1alpha1().Clusters().Delete(ctx, clusterName, metav1.DeleteOptions{})
 	if apierrors.IsNotFound(err) {
 		common.Fail(c, fmt.Errorf("no cluster object %s found in karmada control Plane", clusterName))
 		return
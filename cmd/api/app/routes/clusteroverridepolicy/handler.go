@@ -33,8 +33,26 @@ import (
 )
 
 func handleGetClusterOverridePolicyList(c *gin.Context) {
-	karmadaClient := client.InClusterKarmadaClient()
-	dataSelect := common.ParseDataSelectPathParameter(c)
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if common.IsWatch(c) {
+		watcher, err := clusteroverridepolicy.WatchClusterOverridePolicyList(karmadaClient, dataSelect, common.ParseResourceVersion(c))
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		common.StreamWatch(c, watcher)
+		return
+	}
 	clusterOverrideList, err := clusteroverridepolicy.GetClusterOverridePolicyList(karmadaClient, dataSelect)
 	if err != nil {
 		klog.ErrorS(err, "Failed to GetClusterOverridePolicyList")
@@ -45,7 +63,12 @@ func handleGetClusterOverridePolicyList(c *gin.Context) {
 }
 
 func handleGetClusterOverridePolicyDetail(c *gin.Context) {
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	name := c.Param("clusterOverridePolicyName")
 	result, err := clusteroverridepolicy.GetClusterOverridePolicyDetail(karmadaClient, name)
 	if err != nil {
@@ -65,7 +88,12 @@ func handlePostClusterOverridePolicy(c *gin.Context) {
 	}
 
 	var err error
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	if overridepolicyRequest.IsClusterScope {
 		clusterOverridePolicy := v1alpha1.ClusterOverridePolicy{}
 		if err = yaml.Unmarshal([]byte(overridepolicyRequest.OverrideData), &clusterOverridePolicy); err != nil {
@@ -73,6 +101,7 @@ func handlePostClusterOverridePolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
+		router.SetAuditObject(c, "", clusterOverridePolicy.Name)
 		_, err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Create(ctx, &clusterOverridePolicy, metav1.CreateOptions{})
 	} else {
 		overridePolicy := v1alpha1.OverridePolicy{}
@@ -81,6 +110,7 @@ func handlePostClusterOverridePolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
+		router.SetAuditObject(c, overridepolicyRequest.Namespace, overridePolicy.Name)
 		_, err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Create(ctx, &overridePolicy, metav1.CreateOptions{})
 	}
 	if err != nil {
@@ -33,8 +33,26 @@ import (
 )
 
 func handleGetClusterPropagationPolicyList(c *gin.Context) {
-	karmadaClient := client.InClusterKarmadaClient()
-	dataSelect := common.ParseDataSelectPathParameter(c)
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if common.IsWatch(c) {
+		watcher, err := clusterpropagationpolicy.WatchClusterPropagationPolicyList(karmadaClient, dataSelect, common.ParseResourceVersion(c))
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		common.StreamWatch(c, watcher)
+		return
+	}
 	clusterPropagationList, err := clusterpropagationpolicy.GetClusterPropagationPolicyList(karmadaClient, dataSelect)
 	if err != nil {
 		klog.ErrorS(err, "Failed to GetClusterPropagationPolicyList")
@@ -45,7 +63,12 @@ func handleGetClusterPropagationPolicyList(c *gin.Context) {
 }
 
 func handleGetClusterPropagationPolicyDetail(c *gin.Context) {
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	name := c.Param("clusterPropagationPolicyName")
 	result, err := clusterpropagationpolicy.GetClusterPropagationPolicyDetail(karmadaClient, name)
 	if err != nil {
@@ -65,7 +88,12 @@ func handlePostClusterPropagationPolicy(c *gin.Context) {
 	}
 
 	var err error
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	if propagationpolicyRequest.IsClusterScope {
 		clusterPropagationPolicy := v1alpha1.ClusterPropagationPolicy{}
 		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &clusterPropagationPolicy); err != nil {
@@ -73,6 +101,7 @@ func handlePostClusterPropagationPolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
+		router.SetAuditObject(c, "", clusterPropagationPolicy.Name)
 		_, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Create(ctx, &clusterPropagationPolicy, metav1.CreateOptions{})
 	} else {
 		propagationPolicy := v1alpha1.PropagationPolicy{}
@@ -81,6 +110,7 @@ func handlePostClusterPropagationPolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
+		router.SetAuditObject(c, propagationpolicyRequest.Namespace, propagationPolicy.Name)
 		_, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Create(ctx, &propagationPolicy, metav1.CreateOptions{})
 	}
 	if err != nil {
@@ -17,24 +17,71 @@ limitations under the License.
 package config
 
 import (
+	"strconv"
+
 	"github.com/gin-gonic/gin"
 	"k8s.io/klog/v2"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/auth/rbac"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
 	"github.com/karmada-io/dashboard/pkg/config"
 )
 
 // GetDashboardConfig handles the request to retrieve the dashboard configuration.
+// Registry passwords are never returned. With dashboard RBAC enabled, the menus are filtered by the
+// roles of the user and the roles and bindings are only shown to users who may change the config.
 func GetDashboardConfig(c *gin.Context) {
-	dashboardConfig := config.GetDashboardConfig()
+	dashboardConfig, err := filterConfigForUser(c, config.GetDashboardConfig())
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	common.Success(c, dashboardConfig)
 }
 
-// SetDashboardConfig handles the request to update the dashboard configuration.
+// filterConfigForUser hides what the user of the request may not see of the config.
+func filterConfigForUser(c *gin.Context, dashboardConfig config.DashboardConfig) (config.DashboardConfig, error) {
+	dashboardConfig = config.RedactRegistryCredentials(dashboardConfig)
+	authorizer := rbac.NewAuthorizer(config.GetDashboardConfig())
+	if authorizer == nil {
+		return dashboardConfig, nil
+	}
+	userInfo, err := client.GetUserInfo(c.Request)
+	if err != nil {
+		_, err = errors.HandleError(err)
+		return dashboardConfig, err
+	}
+	roles := authorizer.RolesFor(userInfo.Username, userInfo.Groups)
+	dashboardConfig.MenuConfigs = authorizer.FilterMenus(roles, dashboardConfig.MenuConfigs)
+	if !authorizer.Allowed(roles, rbac.VerbWrite, "config") {
+		dashboardConfig.Roles = nil
+		dashboardConfig.RoleBindings = nil
+		dashboardConfig.DefaultRole = ""
+	}
+	return dashboardConfig, nil
+}
+
+// requestUser returns the name of the user of the request for the config history.
+func requestUser(c *gin.Context) string {
+	userInfo, err := client.GetUserInfo(c.Request)
+	if err != nil {
+		return ""
+	}
+	return userInfo.Username
+}
+
+// SetDashboardConfig handles the request to update the dashboard configuration, only dashboard admins
+// may change it. Registry passwords are moved into Secrets, registries sent without a password keep the
+// stored one.
 func SetDashboardConfig(c *gin.Context) {
+	if err := router.RequireAdmin(c.Request); err != nil {
+		common.Fail(c, err)
+		return
+	}
 	setDashboardConfigRequest := new(v1.SetDashboardConfigRequest)
 	if err := c.ShouldBind(setDashboardConfigRequest); err != nil {
 		klog.ErrorS(err, "Could not read SetDashboardConfigRequest")
@@ -52,8 +99,19 @@ func SetDashboardConfig(c *gin.Context) {
 	if len(setDashboardConfigRequest.MenuConfigs) > 0 {
 		dashboardConfig.MenuConfigs = setDashboardConfigRequest.MenuConfigs
 	}
-	k8sClient := client.InClusterClient()
-	err := config.UpdateDashboardConfig(k8sClient, dashboardConfig)
+	if len(setDashboardConfigRequest.Roles) > 0 {
+		dashboardConfig.Roles = setDashboardConfigRequest.Roles
+	}
+	if len(setDashboardConfigRequest.RoleBindings) > 0 {
+		dashboardConfig.RoleBindings = setDashboardConfigRequest.RoleBindings
+	}
+	if setDashboardConfigRequest.DefaultRole != nil {
+		dashboardConfig.DefaultRole = *setDashboardConfigRequest.DefaultRole
+	}
+	err := config.UpdateDashboardConfig(client.InClusterClient(), config.GetConfigMapRef(), dashboardConfig, config.UpdateOptions{
+		ResourceVersion: setDashboardConfigRequest.ResourceVersion,
+		User:            requestUser(c),
+	})
 	if err != nil {
 		klog.ErrorS(err, "Error updating dashboard config")
 		common.Fail(c, err)
@@ -62,8 +120,93 @@ func SetDashboardConfig(c *gin.Context) {
 	common.Success(c, "ok")
 }
 
+// GetDashboardConfigHistory handles the request to list the previous revisions of the dashboard configuration.
+func GetDashboardConfigHistory(c *gin.Context) {
+	revisions, err := config.ListConfigHistory(client.InClusterClient(), config.GetConfigMapRef())
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	for i := range revisions {
+		if revisions[i].Config, err = filterConfigForUser(c, revisions[i].Config); err != nil {
+			common.Fail(c, err)
+			return
+		}
+	}
+	common.Success(c, revisions)
+}
+
+// GetDashboardConfigRevision handles the request to retrieve a previous revision of the dashboard configuration.
+func GetDashboardConfigRevision(c *gin.Context) {
+	revision, err := strconv.ParseInt(c.Param("revision"), 10, 64)
+	if err != nil {
+		common.Fail(c, errors.NewBadRequest("revision must be a number"))
+		return
+	}
+	configRevision, err := config.GetConfigRevision(client.InClusterClient(), config.GetConfigMapRef(), revision)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if configRevision.Config, err = filterConfigForUser(c, configRevision.Config); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, configRevision)
+}
+
+// RollbackDashboardConfig handles the request to restore a previous revision of the dashboard
+// configuration, only dashboard admins may roll it back. The rollback is stored as a new revision.
+func RollbackDashboardConfig(c *gin.Context) {
+	if err := router.RequireAdmin(c.Request); err != nil {
+		common.Fail(c, err)
+		return
+	}
+	rollbackRequest := new(v1.RollbackDashboardConfigRequest)
+	if err := c.ShouldBind(rollbackRequest); err != nil {
+		klog.ErrorS(err, "Could not read RollbackDashboardConfigRequest")
+		common.Fail(c, err)
+		return
+	}
+	k8sClient := client.InClusterClient()
+	configMapRef := config.GetConfigMapRef()
+	configRevision, err := config.GetConfigRevision(k8sClient, configMapRef, rollbackRequest.Revision)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	// secrets of registries removed since are gone, registries only keep the passwords they have now
+	err = config.UpdateDashboardConfig(k8sClient, configMapRef, configRevision.Config, config.UpdateOptions{
+		ResourceVersion: rollbackRequest.ResourceVersion,
+		User:            requestUser(c),
+	})
+	if err != nil {
+		klog.ErrorS(err, "Error rolling back dashboard config", "revision", rollbackRequest.Revision)
+		common.Fail(c, err)
+		return
+	}
+	common.Success(c, "ok")
+}
+
+// GetDashboardConfigStatus handles the request to retrieve the status of the dashboard configuration
+// loads, e.g. parse errors of the ConfigMap.
+func GetDashboardConfigStatus(c *gin.Context) {
+	common.Success(c, config.GetStatus())
+}
+
+// GetFeatureGates handles the request to retrieve the effective dashboard feature gates, features
+// not set in the config are reported with their default.
+func GetFeatureGates(c *gin.Context) {
+	common.Success(c, config.EffectiveFeatureGates())
+}
+
 func init() {
 	r := router.V1()
 	r.GET("/config", GetDashboardConfig)
 	r.POST("/config", SetDashboardConfig)
+	r.GET("/config/status", GetDashboardConfigStatus)
+	r.GET("/config/feature-gates", GetFeatureGates)
+	r.GET("/config/history", GetDashboardConfigHistory)
+	r.GET("/config/history/:revision", GetDashboardConfigRevision)
+	r.POST("/config/rollback", RollbackDashboardConfig)
 }
@@ -0,0 +1,51 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+)
+
+func TestConfigWritesRequireAdmin(t *testing.T) {
+	// the default config has no role bindings, so nobody is a dashboard admin
+	for path, handler := range map[string]gin.HandlerFunc{
+		"/api/v1/config":          SetDashboardConfig,
+		"/api/v1/config/rollback": RollbackDashboardConfig,
+	} {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"revision": 1, "role_bindings": [{"role": "admin", "users": ["mallory"]}]}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		handler(c)
+
+		var response common.BaseResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatal(err)
+		}
+		if response.Error == nil || response.Error.Status != http.StatusForbidden {
+			t.Errorf("expected POST %s to be forbidden, got %s", path, w.Body.String())
+		}
+	}
+}
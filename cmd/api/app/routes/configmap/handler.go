@@ -26,9 +26,21 @@ import (
 )
 
 func handleGetConfigMap(c *gin.Context) {
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
-	dataSelect := common.ParseDataSelectPathParameter(c)
-	nsQuery := common.ParseNamespacePathParameter(c)
+	k8sClient, err := client.GetCachedKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	nsQuery, err := common.ParseNamespacePathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	result, err := configmap.GetConfigMapList(k8sClient, nsQuery, dataSelect)
 	if err != nil {
 		common.Fail(c, err)
@@ -38,7 +50,11 @@ func handleGetConfigMap(c *gin.Context) {
 }
 
 func handleGetConfigMapDetail(c *gin.Context) {
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	k8sClient, err := client.GetCachedKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	namespace := c.Param("namespace")
 	name := c.Param("name")
 	result, err := configmap.GetConfigMapDetail(k8sClient, namespace, name)
@@ -27,9 +27,21 @@ import (
 )
 
 func handleGetDaemonset(c *gin.Context) {
-	namespace := common.ParseNamespacePathParameter(c)
-	dataSelect := common.ParseDataSelectPathParameter(c)
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	namespace, err := common.ParseNamespacePathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	k8sClient, err := client.GetKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	result, err := daemonset.GetDaemonSetList(k8sClient, namespace, dataSelect)
 	if err != nil {
 		common.Fail(c, err)
@@ -41,7 +53,11 @@ func handleGetDaemonset(c *gin.Context) {
 func handleGetDaemonsetDetail(c *gin.Context) {
 	namespace := c.Param("namespace")
 	name := c.Param("statefulset")
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	k8sClient, err := client.GetKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	result, err := daemonset.GetDaemonSetDetail(k8sClient, namespace, name)
 	if err != nil {
 		common.Fail(c, err)
@@ -53,8 +69,16 @@ func handleGetDaemonsetDetail(c *gin.Context) {
 func handleGetDaemonsetEvents(c *gin.Context) {
 	namespace := c.Param("namespace")
 	name := c.Param("statefulset")
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
-	dataSelect := common.ParseDataSelectPathParameter(c)
+	k8sClient, err := client.GetKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		common.Fail(c, err)
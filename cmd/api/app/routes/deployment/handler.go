@@ -22,7 +22,6 @@ import (
 	"github.com/gin-gonic/gin"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/yaml"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
@@ -44,12 +43,7 @@ func handlerCreateDeployment(c *gin.Context) {
 		createDeploymentRequest.Namespace = "default"
 	}
 
-	restConfig, _, err := client.GetKarmadaConfig()
-	if err != nil {
-		common.Fail(c, err)
-		return
-	}
-	clientset, err := kubernetes.NewForConfig(restConfig)
+	clientset, err := client.GetKubeClientFromRequest(c.Request)
 	if err != nil {
 		common.Fail(c, err)
 		return
@@ -59,6 +53,7 @@ func handlerCreateDeployment(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	router.SetAuditObject(c, createDeploymentRequest.Namespace, deployment.Name)
 	result, err := clientset.AppsV1().Deployments(createDeploymentRequest.Namespace).Create(ctx, &deployment, metav1.CreateOptions{})
 	if err != nil {
 		common.Fail(c, err)
@@ -68,9 +63,30 @@ func handlerCreateDeployment(c *gin.Context) {
 }
 
 func handleGetDeployments(c *gin.Context) {
-	namespace := common.ParseNamespacePathParameter(c)
-	dataSelect := common.ParseDataSelectPathParameter(c)
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	namespace, err := common.ParseNamespacePathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	k8sClient, err := client.GetCachedKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if common.IsWatch(c) {
+		watcher, err := deployment.WatchDeploymentList(k8sClient, namespace, dataSelect, common.ParseResourceVersion(c))
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		common.StreamWatch(c, watcher)
+		return
+	}
 	result, err := deployment.GetDeploymentList(k8sClient, namespace, dataSelect)
 	if err != nil {
 		common.Fail(c, err)
@@ -82,7 +98,11 @@ func handleGetDeployments(c *gin.Context) {
 func handleGetDeploymentDetail(c *gin.Context) {
 	namespace := c.Param("namespace")
 	name := c.Param("deployment")
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	k8sClient, err := client.GetCachedKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	result, err := deployment.GetDeploymentDetail(k8sClient, namespace, name)
 	if err != nil {
 		common.Fail(c, err)
@@ -94,8 +114,16 @@ func handleGetDeploymentDetail(c *gin.Context) {
 func handleGetDeploymentEvents(c *gin.Context) {
 	namespace := c.Param("namespace")
 	name := c.Param("deployment")
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
-	dataSelect := common.ParseDataSelectPathParameter(c)
+	k8sClient, err := client.GetKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	result, err := event.GetResourceEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		common.Fail(c, err)
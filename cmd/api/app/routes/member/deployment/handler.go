@@ -27,9 +27,30 @@ import (
 )
 
 func handleGetMemberDeployments(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
-	namespace := common.ParseNamespacePathParameter(c)
-	dataSelect := common.ParseDataSelectPathParameter(c)
+	memberClient, err := client.GetMemberClientFromRequest(c.Request, c.Param("clustername"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace, err := common.ParseMemberNamespacePathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if common.IsWatch(c) {
+		watcher, err := deployment.WatchDeploymentList(memberClient, namespace, dataSelect, common.ParseResourceVersion(c))
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		common.StreamWatch(c, watcher)
+		return
+	}
 	result, err := deployment.GetDeploymentList(memberClient, namespace, dataSelect)
 	if err != nil {
 		common.Fail(c, err)
@@ -39,7 +60,11 @@ func handleGetMemberDeployments(c *gin.Context) {
 }
 
 func handleGetMemberDeploymentDetail(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	memberClient, err := client.GetMemberClientFromRequest(c.Request, c.Param("clustername"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	namespace := c.Param("namespace")
 	name := c.Param("deployment")
 	result, err := deployment.GetDeploymentDetail(memberClient, namespace, name)
@@ -51,10 +76,18 @@ func handleGetMemberDeploymentDetail(c *gin.Context) {
 }
 
 func handleGetMemberDeploymentEvents(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	memberClient, err := client.GetMemberClientFromRequest(c.Request, c.Param("clustername"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	namespace := c.Param("namespace")
 	name := c.Param("deployment")
-	dataSelect := common.ParseDataSelectPathParameter(c)
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	result, err := event.GetResourceEvents(memberClient, dataSelect, namespace, name)
 	if err != nil {
 		common.Fail(c, err)
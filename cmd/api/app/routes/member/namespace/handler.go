@@ -17,20 +17,48 @@ limitations under the License.
 package namespace
 
 import (
+	"fmt"
+
 	"github.com/gin-gonic/gin"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	rescommon "github.com/karmada-io/dashboard/pkg/resource/common"
 	"github.com/karmada-io/dashboard/pkg/resource/event"
 	ns "github.com/karmada-io/dashboard/pkg/resource/namespace"
 )
 
 func handleGetMemberNamespace(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
-
-	dataSelect := common.ParseDataSelectPathParameter(c)
-	result, err := ns.GetNamespaceList(memberClient, dataSelect)
+	memberClient, err := client.GetMemberClientFromRequest(c.Request, c.Param("clustername"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	clusterName := c.Param("clustername")
+	visibility, err := client.GetVisibleMemberNamespaces(c.Request, clusterName)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	nsQuery := rescommon.NewNamespaceQuery(nil)
+	if !visibility.All {
+		// tenants are usually not allowed to list namespaces, list them with the dashboard's identity
+		// and keep the visible ones
+		memberClient = client.InClusterClientForMemberCluster(clusterName)
+		if memberClient == nil {
+			common.Fail(c, errors.NewInternal(fmt.Sprintf("could not init client for member cluster %s", clusterName)))
+			return
+		}
+		nsQuery = nsQuery.Restrict(visibility.Namespaces)
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := ns.GetNamespaceList(memberClient, nsQuery, dataSelect)
 	if err != nil {
 		common.Fail(c, err)
 		return
@@ -39,8 +67,11 @@ func handleGetMemberNamespace(c *gin.Context) {
 }
 
 func handleGetMemberNamespaceDetail(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
-
+	memberClient, err := client.GetMemberClientFromRequest(c.Request, c.Param("clustername"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	name := c.Param("name")
 	result, err := ns.GetNamespaceDetail(memberClient, name)
 	if err != nil {
@@ -51,10 +82,17 @@ func handleGetMemberNamespaceDetail(c *gin.Context) {
 }
 
 func handleGetMemberNamespaceEvents(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
-
+	memberClient, err := client.GetMemberClientFromRequest(c.Request, c.Param("clustername"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	name := c.Param("name")
-	dataSelect := common.ParseDataSelectPathParameter(c)
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	result, err := event.GetNamespaceEvents(memberClient, dataSelect, name)
 	if err != nil {
 		common.Fail(c, err)
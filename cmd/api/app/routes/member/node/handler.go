@@ -17,8 +17,6 @@ limitations under the License.
 package node
 
 import (
-	"fmt"
-
 	"github.com/gin-gonic/gin"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
@@ -29,13 +27,26 @@ import (
 
 func handleGetMemberNodes(c *gin.Context) {
 	clusterName := c.Param("clustername")
-	memberClient := client.InClusterClientForMemberCluster(clusterName)
-	if memberClient == nil {
-		common.Fail(c, fmt.Errorf("failed to get client for cluster %s", clusterName))
+	memberClient, err := client.GetMemberClientFromRequest(c.Request, clusterName)
+	if err != nil {
+		common.Fail(c, err)
 		return
 	}
 
-	dataSelect := common.ParseDataSelectPathParameter(c)
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if common.IsWatch(c) {
+		watcher, err := enhancednode.WatchEnhancedNodeList(memberClient, clusterName, dataSelect, common.ParseResourceVersion(c))
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		common.StreamWatch(c, watcher)
+		return
+	}
 	result, err := enhancednode.GetEnhancedNodeList(memberClient, clusterName, dataSelect)
 	if err != nil {
 		common.Fail(c, err)
@@ -48,9 +59,9 @@ func handleGetMemberNodeDetail(c *gin.Context) {
 	clusterName := c.Param("clustername")
 	nodeName := c.Param("name")
 
-	memberClient := client.InClusterClientForMemberCluster(clusterName)
-	if memberClient == nil {
-		common.Fail(c, fmt.Errorf("failed to get client for cluster %s", clusterName))
+	memberClient, err := client.GetMemberClientFromRequest(c.Request, clusterName)
+	if err != nil {
+		common.Fail(c, err)
 		return
 	}
 
@@ -66,13 +77,17 @@ func handleGetMemberNodePods(c *gin.Context) {
 	clusterName := c.Param("clustername")
 	nodeName := c.Param("name")
 
-	memberClient := client.InClusterClientForMemberCluster(clusterName)
-	if memberClient == nil {
-		common.Fail(c, fmt.Errorf("failed to get client for cluster %s", clusterName))
+	memberClient, err := client.GetMemberClientFromRequest(c.Request, clusterName)
+	if err != nil {
+		common.Fail(c, err)
 		return
 	}
 
-	dataSelect := common.ParseDataSelectPathParameter(c)
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	result, err := enhancednode.GetPodsOnNode(memberClient, nodeName, dataSelect)
 	if err != nil {
 		common.Fail(c, err)
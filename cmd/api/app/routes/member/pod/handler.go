@@ -27,9 +27,30 @@ import (
 
 // return a pods list
 func handleGetMemberPod(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
-	dataSelect := common.ParseDataSelectPathParameter(c)
-	nsQuery := common.ParseNamespacePathParameter(c)
+	memberClient, err := client.GetMemberClientFromRequest(c.Request, c.Param("clustername"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseCursorDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	nsQuery, err := common.ParseMemberNamespacePathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if common.IsWatch(c) {
+		watcher, err := pod.WatchPodList(memberClient, nsQuery, dataSelect, common.ParseResourceVersion(c))
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		common.StreamWatch(c, watcher)
+		return
+	}
 	result, err := pod.GetPodList(memberClient, nsQuery, dataSelect)
 	if err != nil {
 		common.Fail(c, err)
@@ -40,7 +61,11 @@ func handleGetMemberPod(c *gin.Context) {
 
 // return a pod detail
 func handleGetMemberPodDetail(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	memberClient, err := client.GetMemberClientFromRequest(c.Request, c.Param("clustername"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	namespace := c.Param("namespace")
 	name := c.Param("name")
 	result, err := pod.GetPodDetail(memberClient, namespace, name)
@@ -27,9 +27,21 @@ import (
 
 // return a services list
 func handleGetMemberService(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
-	dataSelect := common.ParseDataSelectPathParameter(c)
-	nsQuery := common.ParseNamespacePathParameter(c)
+	memberClient, err := client.GetMemberClientFromRequest(c.Request, c.Param("clustername"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	nsQuery, err := common.ParseMemberNamespacePathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	result, err := service.GetServiceList(memberClient, nsQuery, dataSelect)
 	if err != nil {
 		common.Fail(c, err)
@@ -40,7 +52,11 @@ func handleGetMemberService(c *gin.Context) {
 
 // return a service detail
 func handleGetMemberServiceDetail(c *gin.Context) {
-	memberClient := client.InClusterClientForMemberCluster(c.Param("clustername"))
+	memberClient, err := client.GetMemberClientFromRequest(c.Request, c.Param("clustername"))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	namespace := c.Param("namespace")
 	name := c.Param("name")
 	result, err := service.GetServiceDetail(memberClient, namespace, name)
@@ -23,17 +23,23 @@ import (
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
+	rescommon "github.com/karmada-io/dashboard/pkg/resource/common"
 	"github.com/karmada-io/dashboard/pkg/resource/event"
 	ns "github.com/karmada-io/dashboard/pkg/resource/namespace"
 )
 
 func handleCreateNamespace(c *gin.Context) {
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	k8sClient, err := client.GetKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	createNamespaceRequest := new(v1.CreateNamesapceRequest)
 	if err := c.ShouldBind(&createNamespaceRequest); err != nil {
 		common.Fail(c, err)
 		return
 	}
+	router.SetAuditObject(c, "", createNamespaceRequest.Name)
 	spec := &ns.NamespaceSpec{
 		Name:                createNamespaceRequest.Name,
 		SkipAutoPropagation: createNamespaceRequest.SkipAutoPropagation,
@@ -45,9 +51,29 @@ func handleCreateNamespace(c *gin.Context) {
 	common.Success(c, "ok")
 }
 func handleGetNamespaces(c *gin.Context) {
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
-	dataSelect := common.ParseDataSelectPathParameter(c)
-	result, err := ns.GetNamespaceList(k8sClient, dataSelect)
+	k8sClient, err := client.GetCachedKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	visibility, err := client.GetVisibleNamespaces(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	nsQuery := rescommon.NewNamespaceQuery(nil)
+	if !visibility.All {
+		// tenants are usually not allowed to list namespaces, list them with the dashboard's identity
+		// and keep the visible ones
+		k8sClient = client.InClusterClientForKarmadaAPIServer()
+		nsQuery = nsQuery.Restrict(visibility.Namespaces)
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	result, err := ns.GetNamespaceList(k8sClient, nsQuery, dataSelect)
 	if err != nil {
 		common.Fail(c, err)
 		return
@@ -55,7 +81,11 @@ func handleGetNamespaces(c *gin.Context) {
 	common.Success(c, result)
 }
 func handleGetNamespaceDetail(c *gin.Context) {
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	k8sClient, err := client.GetCachedKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	name := c.Param("name")
 	result, err := ns.GetNamespaceDetail(k8sClient, name)
 	if err != nil {
@@ -65,9 +95,17 @@ func handleGetNamespaceDetail(c *gin.Context) {
 	common.Success(c, result)
 }
 func handleGetNamespaceEvents(c *gin.Context) {
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	k8sClient, err := client.GetKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	name := c.Param("name")
-	dataSelect := common.ParseDataSelectPathParameter(c)
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	result, err := event.GetNamespaceEvents(k8sClient, dataSelect, name)
 	if err != nil {
 		common.Fail(c, err)
@@ -35,10 +35,37 @@ import (
 )
 
 func handleGetOverridePolicyList(c *gin.Context) {
-	karmadaClient := client.InClusterKarmadaClient()
-	dataSelect := common.ParseDataSelectPathParameter(c)
-	namespace := common.ParseNamespacePathParameter(c)
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace, err := common.ParseNamespacePathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if common.IsWatch(c) {
+		watcher, err := overridepolicy.WatchOverridePolicyList(karmadaClient, namespace, dataSelect, common.ParseResourceVersion(c))
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		common.StreamWatch(c, watcher)
+		return
+	}
+	k8sClient, err := client.GetCachedKubeClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init kubernetes client")
+		common.Fail(c, err)
+		return
+	}
 	overrideList, err := overridepolicy.GetOverridePolicyList(karmadaClient, k8sClient, namespace, dataSelect)
 	if err != nil {
 		klog.ErrorS(err, "Failed to GetOverridePolicyList")
@@ -48,7 +75,12 @@ func handleGetOverridePolicyList(c *gin.Context) {
 	common.Success(c, overrideList)
 }
 func handleGetOverridePolicyDetail(c *gin.Context) {
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	namespace := c.Param("namespace")
 	name := c.Param("overridePolicyName")
 	result, err := overridepolicy.GetOverridePolicyDetail(karmadaClient, namespace, name)
@@ -72,7 +104,12 @@ func handlePostOverridePolicy(c *gin.Context) {
 	}
 
 	var err error
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	if overridepolicyRequest.IsClusterScope {
 		clusteroverridePolicy := v1alpha1.ClusterOverridePolicy{}
 		if err = yaml.Unmarshal([]byte(overridepolicyRequest.OverrideData), &clusteroverridePolicy); err != nil {
@@ -80,6 +117,7 @@ func handlePostOverridePolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
+		router.SetAuditObject(c, "", clusteroverridePolicy.Name)
 		_, err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Create(ctx, &clusteroverridePolicy, metav1.CreateOptions{})
 	} else {
 		overridePolicy := v1alpha1.OverridePolicy{}
@@ -88,6 +126,7 @@ func handlePostOverridePolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
+		router.SetAuditObject(c, overridepolicyRequest.Namespace, overridePolicy.Name)
 		_, err = karmadaClient.PolicyV1alpha1().OverridePolicies(overridepolicyRequest.Namespace).Create(ctx, &overridePolicy, metav1.CreateOptions{})
 	}
 	if err != nil {
@@ -104,8 +143,14 @@ func handlePutOverridePolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	router.SetAuditObject(c, overridepolicyRequest.Namespace, overridepolicyRequest.Name)
 	var err error
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	// todo check pp exist
 	if overridepolicyRequest.IsClusterScope {
 		clusteroverridePolicy := v1alpha1.ClusterOverridePolicy{}
@@ -145,8 +190,14 @@ func handleDeleteOverridePolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	router.SetAuditObject(c, overridepolicyRequest.Namespace, overridepolicyRequest.Name)
 	var err error
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	if overridepolicyRequest.IsClusterScope {
 		err = karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().Delete(ctx, overridepolicyRequest.Name, metav1.DeleteOptions{})
 		if err != nil {
@@ -22,22 +22,43 @@ import (
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	v1 "github.com/karmada-io/dashboard/cmd/api/app/types/api/v1"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/client"
+	rescommon "github.com/karmada-io/dashboard/pkg/resource/common"
 )
 
 func handleGetOverview(c *gin.Context) {
-	dataSelect := common.ParseDataSelectPathParameter(c)
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	karmadaInfo, err := GetControllerManagerInfo()
 	if err != nil {
 		common.Fail(c, err)
 		return
 	}
-	memberClusterStatus, err := GetMemberClusterInfo(dataSelect)
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	kubeClient, err := client.GetCachedKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	memberClusterStatus, err := GetMemberClusterInfo(karmadaClient, dataSelect)
 	if err != nil {
 		common.Fail(c, err)
 		return
 	}
 
-	clusterResourceStatus, err := GetClusterResourceStatus()
+	nsQuery, err := common.RestrictNamespaceQuery(c.Request, rescommon.NewNamespaceQuery(nil))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	clusterResourceStatus, err := GetClusterResourceStatus(karmadaClient, kubeClient, nsQuery)
 	if err != nil {
 		common.Fail(c, err)
 		return
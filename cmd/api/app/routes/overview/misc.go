@@ -24,10 +24,15 @@ import (
 	"math/big"
 	"strings"
 
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
 	"github.com/karmada-io/karmada/pkg/version"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/remotecommand"
 
@@ -35,6 +40,7 @@ import (
 	"github.com/karmada-io/dashboard/pkg/client"
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 	"github.com/karmada-io/dashboard/pkg/resource/cluster"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
 )
 
 const (
@@ -161,8 +167,7 @@ func GetControllerManagerInfo() (*v1.KarmadaInfo, error) {
 }
 
 // GetMemberClusterInfo returns the status of member clusters.
-func GetMemberClusterInfo(ds *dataselect.DataSelectQuery) (*v1.MemberClusterStatus, error) {
-	karmadaClient := client.InClusterKarmadaClient()
+func GetMemberClusterInfo(karmadaClient karmadaclientset.Interface, ds *dataselect.DataSelectQuery) (*v1.MemberClusterStatus, error) {
 	result, err := cluster.GetClusterList(karmadaClient, ds)
 	if err != nil {
 		return nil, err
@@ -193,32 +198,40 @@ func GetMemberClusterInfo(ds *dataselect.DataSelectQuery) (*v1.MemberClusterStat
 	return memberClusterStatus, nil
 }
 
-// GetClusterResourceStatus returns the status of cluster resources.
-func GetClusterResourceStatus() (*v1.ClusterResourceStatus, error) {
+// GetClusterResourceStatus returns the status of cluster resources in the namespaces of nsQuery.
+// Cluster scoped policies are only counted when the query is not restricted to a tenant's namespaces.
+func GetClusterResourceStatus(karmadaClient karmadaclientset.Interface, kubeClient kubernetes.Interface, nsQuery *common.NamespaceQuery) (*v1.ClusterResourceStatus, error) {
 	clusterResourceStatus := &v1.ClusterResourceStatus{}
 	ctx := context.TODO()
-	karmadaClient := client.InClusterKarmadaClient()
 	// handle pp num
-	clusterPPRet, err := karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+	if !nsQuery.Restricted() {
+		clusterPPRet, err := karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		clusterResourceStatus.PropagationPolicyNum += len(clusterPPRet.Items)
 	}
-	clusterResourceStatus.PropagationPolicyNum += len(clusterPPRet.Items)
 
-	ppRet, err := karmadaClient.PolicyV1alpha1().PropagationPolicies("").List(ctx, metav1.ListOptions{})
+	ppRet, err := common.ListInNamespaces(nsQuery, func(namespace string) (*policyv1alpha1.PropagationPolicyList, error) {
+		return karmadaClient.PolicyV1alpha1().PropagationPolicies(namespace).List(ctx, metav1.ListOptions{})
+	})
 	if err != nil {
 		return nil, err
 	}
 	clusterResourceStatus.PropagationPolicyNum += len(ppRet.Items)
 
 	// handle op num
-	clusterOPRet, err := karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+	if !nsQuery.Restricted() {
+		clusterOPRet, err := karmadaClient.PolicyV1alpha1().ClusterOverridePolicies().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		clusterResourceStatus.OverridePolicyNum += len(clusterOPRet.Items)
 	}
-	clusterResourceStatus.OverridePolicyNum += len(clusterOPRet.Items)
 
-	opRet, err := karmadaClient.PolicyV1alpha1().OverridePolicies("").List(ctx, metav1.ListOptions{})
+	opRet, err := common.ListInNamespaces(nsQuery, func(namespace string) (*policyv1alpha1.OverridePolicyList, error) {
+		return karmadaClient.PolicyV1alpha1().OverridePolicies(namespace).List(ctx, metav1.ListOptions{})
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -226,40 +239,53 @@ func GetClusterResourceStatus() (*v1.ClusterResourceStatus, error) {
 
 	// handle cluster resources
 	// handler namespace num
-	kubeClient := client.InClusterClientForKarmadaAPIServer()
-	nsRet, err := kubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, err
+	if nsQuery.Restricted() {
+		clusterResourceStatus.NamespaceNum += len(nsQuery.Namespaces())
+	} else {
+		nsRet, err := kubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		clusterResourceStatus.NamespaceNum += len(nsRet.Items)
 	}
-	clusterResourceStatus.NamespaceNum += len(nsRet.Items)
 
 	// handle workload num
 	// currently only deployment is allowed
-	deploymentRet, err := kubeClient.AppsV1().Deployments("").List(ctx, metav1.ListOptions{})
+	deploymentRet, err := common.ListInNamespaces(nsQuery, func(namespace string) (*appsv1.DeploymentList, error) {
+		return kubeClient.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	})
 	if err != nil {
 		return nil, err
 	}
 	clusterResourceStatus.WorkloadNum += len(deploymentRet.Items)
 
 	// handle configmap & secret num
-	secretRet, err := kubeClient.CoreV1().Secrets("").List(ctx, metav1.ListOptions{})
+	secretRet, err := common.ListInNamespaces(nsQuery, func(namespace string) (*corev1.SecretList, error) {
+		return kubeClient.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+	})
 	if err != nil {
 		return nil, err
 	}
 	clusterResourceStatus.ConfigNum += len(secretRet.Items)
-	cmRet, err := kubeClient.CoreV1().ConfigMaps("").List(ctx, metav1.ListOptions{})
+	cmRet, err := common.ListInNamespaces(nsQuery, func(namespace string) (*corev1.ConfigMapList, error) {
+		return kubeClient.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+	})
 	if err != nil {
 		return nil, err
 	}
 	clusterResourceStatus.ConfigNum += len(cmRet.Items)
 
 	// handle service & ingress num
-	svcRet, err := kubeClient.CoreV1().Services("").List(ctx, metav1.ListOptions{})
+	svcRet, err := common.ListInNamespaces(nsQuery, func(namespace string) (*corev1.ServiceList, error) {
+		return kubeClient.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	})
 	if err != nil {
 		return nil, err
 	}
 	clusterResourceStatus.ServiceNum += len(svcRet.Items)
-	ingressRet, err := kubeClient.NetworkingV1().Ingresses("").List(ctx, metav1.ListOptions{})
+	ingressRet, err := common.ListInNamespaces(nsQuery, func(namespace string) (*networkingv1.IngressList, error) {
+		return kubeClient.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{})
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -35,10 +35,37 @@ import (
 )
 
 func handleGetPropagationPolicyList(c *gin.Context) {
-	karmadaClient := client.InClusterKarmadaClient()
-	dataSelect := common.ParseDataSelectPathParameter(c)
-	namespace := common.ParseNamespacePathParameter(c)
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	namespace, err := common.ParseNamespacePathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	if common.IsWatch(c) {
+		watcher, err := propagationpolicy.WatchPropagationPolicyList(karmadaClient, namespace, dataSelect, common.ParseResourceVersion(c))
+		if err != nil {
+			common.Fail(c, err)
+			return
+		}
+		common.StreamWatch(c, watcher)
+		return
+	}
+	k8sClient, err := client.GetCachedKubeClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init kubernetes client")
+		common.Fail(c, err)
+		return
+	}
 	propagationList, err := propagationpolicy.GetPropagationPolicyList(karmadaClient, k8sClient, namespace, dataSelect)
 	if err != nil {
 		klog.ErrorS(err, "Failed to GetPropagationPolicyList")
@@ -48,7 +75,12 @@ func handleGetPropagationPolicyList(c *gin.Context) {
 	common.Success(c, propagationList)
 }
 func handleGetPropagationPolicyDetail(c *gin.Context) {
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	namespace := c.Param("namespace")
 	name := c.Param("propagationPolicyName")
 	result, err := propagationpolicy.GetPropagationPolicyDetail(karmadaClient, namespace, name)
@@ -72,7 +104,12 @@ func handlePostPropagationPolicy(c *gin.Context) {
 	}
 
 	var err error
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	if propagationpolicyRequest.IsClusterScope {
 		clusterpropagationPolicy := v1alpha1.ClusterPropagationPolicy{}
 		if err = yaml.Unmarshal([]byte(propagationpolicyRequest.PropagationData), &clusterpropagationPolicy); err != nil {
@@ -80,6 +117,7 @@ func handlePostPropagationPolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
+		router.SetAuditObject(c, "", clusterpropagationPolicy.Name)
 		_, err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Create(ctx, &clusterpropagationPolicy, metav1.CreateOptions{})
 	} else {
 		propagationPolicy := v1alpha1.PropagationPolicy{}
@@ -88,6 +126,7 @@ func handlePostPropagationPolicy(c *gin.Context) {
 			common.Fail(c, err)
 			return
 		}
+		router.SetAuditObject(c, propagationpolicyRequest.Namespace, propagationPolicy.Name)
 		_, err = karmadaClient.PolicyV1alpha1().PropagationPolicies(propagationpolicyRequest.Namespace).Create(ctx, &propagationPolicy, metav1.CreateOptions{})
 	}
 	if err != nil {
@@ -104,8 +143,14 @@ func handlePutPropagationPolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	router.SetAuditObject(c, propagationpolicyRequest.Namespace, propagationpolicyRequest.Name)
 	var err error
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	// todo check pp exist
 	if propagationpolicyRequest.IsClusterScope {
 		clusterpropagationPolicy := v1alpha1.ClusterPropagationPolicy{}
@@ -145,8 +190,14 @@ func handleDeletePropagationPolicy(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	router.SetAuditObject(c, propagationpolicyRequest.Namespace, propagationpolicyRequest.Name)
 	var err error
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetKarmadaClientFromRequest(c.Request)
+	if err != nil {
+		klog.ErrorS(err, "Failed to init karmada client")
+		common.Fail(c, err)
+		return
+	}
 	if propagationpolicyRequest.IsClusterScope {
 		err = karmadaClient.PolicyV1alpha1().ClusterPropagationPolicies().Delete(ctx, propagationpolicyRequest.Name, metav1.DeleteOptions{})
 		if err != nil {
@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -21,6 +22,9 @@ import (
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/middleware"
+	"github.com/karmada-io/dashboard/pkg/features"
+	rescommon "github.com/karmada-io/dashboard/pkg/resource/common"
 	schedulingpkg "github.com/karmada-io/dashboard/pkg/resource/scheduling"
 	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
@@ -138,7 +142,17 @@ func handleGetWorkloadScheduling(c *gin.Context) {
 		kind = "Deployment" // 默认值
 	}
 
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+
+	if err != nil {
+
+		klog.ErrorS(err, "Failed to init karmada client")
+
+		common.Fail(c, err)
+
+		return
+
+	}
 	result, err := schedulingpkg.GetWorkloadScheduling(karmadaClient, namespace, name, kind)
 	if err != nil {
 		common.Fail(c, err)
@@ -157,7 +171,17 @@ func handleGetPreciseSchedulingInfo(c *gin.Context) {
 		kind = "Deployment"
 	}
 
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+
+	if err != nil {
+
+		klog.ErrorS(err, "Failed to init karmada client")
+
+		common.Fail(c, err)
+
+		return
+
+	}
 	
 	// 获取基础调度信息
 	basicInfo, err := schedulingpkg.GetWorkloadScheduling(karmadaClient, namespace, name, kind)
@@ -168,7 +192,7 @@ func handleGetPreciseSchedulingInfo(c *gin.Context) {
 	}
 
 	// 增强调度信息，获取节点级别详情
-	preciseInfo, err := enhanceSchedulingInfo(basicInfo)
+	preciseInfo, err := enhanceSchedulingInfo(c.Request, basicInfo)
 	if err != nil {
 		klog.ErrorS(err, "增强调度信息失败", "namespace", namespace, "name", name)
 		common.Fail(c, err)
@@ -182,9 +206,28 @@ func handleGetPreciseSchedulingInfo(c *gin.Context) {
 func handleGetSchedulingOverview(c *gin.Context) {
 	namespaceFilter := c.Query("namespace")
 	
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+	
+	if err != nil {
+	
+		klog.ErrorS(err, "Failed to init karmada client")
+	
+		common.Fail(c, err)
+	
+		return
+	
+	}
 	
-	overview, err := getSchedulingOverview(karmadaClient, namespaceFilter)
+	var namespaces []string
+	if namespaceFilter != "" {
+		namespaces = []string{namespaceFilter}
+	}
+	nsQuery, err := common.RestrictNamespaceQuery(c.Request, rescommon.NewNamespaceQuery(namespaces))
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	overview, err := getSchedulingOverview(karmadaClient, nsQuery)
 	if err != nil {
 		klog.ErrorS(err, "获取调度概览失败")
 		common.Fail(c, err)
@@ -201,7 +244,17 @@ func handleGetNamespaceWorkloadsScheduling(c *gin.Context) {
 	pageSize := parseIntParameter(c, "pageSize", 20)
 	kindFilter := c.Query("kind")
 
-	karmadaClient := client.InClusterKarmadaClient()
+	karmadaClient, err := client.GetCachedKarmadaClientFromRequest(c.Request)
+
+	if err != nil {
+
+		klog.ErrorS(err, "Failed to init karmada client")
+
+		common.Fail(c, err)
+
+		return
+
+	}
 	
 	result, err := getNamespaceWorkloadsScheduling(karmadaClient, namespace, page, pageSize, kindFilter)
 	if err != nil {
@@ -214,7 +267,7 @@ func handleGetNamespaceWorkloadsScheduling(c *gin.Context) {
 }
 
 // 增强调度信息，获取节点级别详情
-func enhanceSchedulingInfo(basicInfo *schedulingpkg.WorkloadSchedulingView) (*PreciseSchedulingInfo, error) {
+func enhanceSchedulingInfo(request *http.Request, basicInfo *schedulingpkg.WorkloadSchedulingView) (*PreciseSchedulingInfo, error) {
 	preciseInfo := &PreciseSchedulingInfo{
 		WorkloadInfo:      basicInfo.WorkloadInfo,
 		PropagationPolicy: basicInfo.PropagationPolicy,
@@ -250,7 +303,7 @@ func enhanceSchedulingInfo(basicInfo *schedulingpkg.WorkloadSchedulingView) (*Pr
 			}
 
 			// 获取集群中的节点级别调度信息
-			nodePlacements, err := getNodePlacementsInCluster(placement.ClusterName, basicInfo.WorkloadInfo)
+			nodePlacements, err := getNodePlacementsInCluster(request, placement.ClusterName, basicInfo.WorkloadInfo)
 			if err != nil {
 				klog.ErrorS(err, "获取集群节点调度信息失败", "cluster", placement.ClusterName)
 				// 不让单个集群的错误影响整个请求
@@ -292,7 +345,7 @@ func enhanceSchedulingInfo(basicInfo *schedulingpkg.WorkloadSchedulingView) (*Pr
 
 				// 即使没有实际调度，也可以获取集群的节点信息作为潜在的调度目标
 				if basicInfo.SchedulingStatus.Phase == "Pending" {
-					potentialNodes, err := getPotentialNodesInCluster(clusterName)
+					potentialNodes, err := getPotentialNodesInCluster(request, clusterName)
 					if err != nil {
 						klog.ErrorS(err, "获取集群潜在节点失败", "cluster", clusterName)
 					} else {
@@ -320,10 +373,10 @@ func enhanceSchedulingInfo(basicInfo *schedulingpkg.WorkloadSchedulingView) (*Pr
 }
 
 // 获取集群的潜在节点信息（用于尚未调度的工作负载）
-func getPotentialNodesInCluster(clusterName string) ([]NodePlacement, error) {
-	memberClient := client.InClusterClientForMemberCluster(clusterName)
-	if memberClient == nil {
-		return nil, fmt.Errorf("无法获取集群 %s 的客户端", clusterName)
+func getPotentialNodesInCluster(request *http.Request, clusterName string) ([]NodePlacement, error) {
+	memberClient, err := client.GetMemberClientFromRequest(request, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("无法获取集群 %s 的客户端: %w", clusterName, err)
 	}
 
 	// 获取节点列表
@@ -368,15 +421,15 @@ func getPotentialNodesInCluster(clusterName string) ([]NodePlacement, error) {
 }
 
 // 获取集群中的节点级别调度信息
-func getNodePlacementsInCluster(clusterName string, workloadInfo schedulingpkg.WorkloadInfo) ([]NodePlacement, error) {
+func getNodePlacementsInCluster(request *http.Request, clusterName string, workloadInfo schedulingpkg.WorkloadInfo) ([]NodePlacement, error) {
 	klog.InfoS("开始获取集群节点调度信息", 
 		"cluster", clusterName, 
 		"workload", fmt.Sprintf("%s/%s", workloadInfo.Namespace, workloadInfo.Name),
 		"kind", workloadInfo.Kind)
 
-	memberClient := client.InClusterClientForMemberCluster(clusterName)
-	if memberClient == nil {
-		return nil, fmt.Errorf("无法获取集群 %s 的客户端", clusterName)
+	memberClient, err := client.GetMemberClientFromRequest(request, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("无法获取集群 %s 的客户端: %w", clusterName, err)
 	}
 
 	// 获取节点列表
@@ -609,25 +662,17 @@ func getTotalRestartCount(containerStatuses []corev1.ContainerStatus) int32 {
 }
 
 // 获取调度概览信息
-func getSchedulingOverview(karmadaClient karmadaclientset.Interface, namespaceFilter string) (*SchedulingOverview, error) {
-	klog.InfoS("开始获取调度概览", "namespaceFilter", namespaceFilter)
+func getSchedulingOverview(karmadaClient karmadaclientset.Interface, nsQuery *rescommon.NamespaceQuery) (*SchedulingOverview, error) {
+	klog.InfoS("开始获取调度概览", "namespaces", nsQuery.Namespaces(), "restricted", nsQuery.Restricted())
 	
-	// 获取所有ResourceBinding
-	var resourceBindings []workv1alpha2.ResourceBinding
-	if namespaceFilter != "" {
-		bindings, err := karmadaClient.WorkV1alpha2().ResourceBindings(namespaceFilter).List(context.TODO(), metav1.ListOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to list resource bindings in namespace %s: %w", namespaceFilter, err)
-		}
-		resourceBindings = bindings.Items
-	} else {
-		// 获取所有命名空间的ResourceBinding
-		bindings, err := karmadaClient.WorkV1alpha2().ResourceBindings("").List(context.TODO(), metav1.ListOptions{})
-		if err != nil {
-			return nil, fmt.Errorf("failed to list all resource bindings: %w", err)
-		}
-		resourceBindings = bindings.Items
+	// 获取查询范围内的ResourceBinding
+	bindings, err := rescommon.ListInNamespaces(nsQuery, func(namespace string) (*workv1alpha2.ResourceBindingList, error) {
+		return karmadaClient.WorkV1alpha2().ResourceBindings(namespace).List(context.TODO(), metav1.ListOptions{})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource bindings: %w", err)
 	}
+	resourceBindings := bindings.Items
 
 	klog.InfoS("获取到ResourceBinding列表", "count", len(resourceBindings))
 
@@ -935,7 +980,7 @@ func init() {
 	// 基础调度信息
 	r.GET("/workloads/:namespace/:name/scheduling", handleGetWorkloadScheduling)
 	// 精确调度信息（包含节点级别详情）
-	r.GET("/workloads/:namespace/:name/precise-scheduling", handleGetPreciseSchedulingInfo)
+	r.GET("/workloads/:namespace/:name/precise-scheduling", middleware.FeatureGate(features.PreciseScheduling, common.Fail), handleGetPreciseSchedulingInfo)
 	// 调度概览
 	r.GET("/scheduling/overview", handleGetSchedulingOverview)
 	// 批量获取命名空间工作负载调度信息
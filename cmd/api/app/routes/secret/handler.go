@@ -17,18 +17,38 @@ limitations under the License.
 package service
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/gin-gonic/gin"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/klog/v2"
 
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
+	"github.com/karmada-io/dashboard/pkg/audit"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
 	"github.com/karmada-io/dashboard/pkg/resource/secret"
 )
 
 func handleGetSecrets(c *gin.Context) {
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
-	dataSelect := common.ParseDataSelectPathParameter(c)
-	nsQuery := common.ParseNamespacePathParameter(c)
+	k8sClient, err := client.GetKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	nsQuery, err := common.ParseNamespacePathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	result, err := secret.GetSecretList(k8sClient, nsQuery, dataSelect)
 	if err != nil {
 		common.Fail(c, err)
@@ -38,7 +58,11 @@ func handleGetSecrets(c *gin.Context) {
 }
 
 func handleGetSecretDetail(c *gin.Context) {
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	k8sClient, err := client.GetKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	namespace := c.Param("namespace")
 	name := c.Param("service")
 	result, err := secret.GetSecretDetail(k8sClient, namespace, name)
@@ -48,9 +72,76 @@ func handleGetSecretDetail(c *gin.Context) {
 	}
 	common.Success(c, result)
 }
+
+// handleRevealSecret returns the decoded values of a secret, limited to the keys given as repeated
+// key query parameters. The user must be allowed to get the secret, every attempt is audited.
+func handleRevealSecret(c *gin.Context) {
+	namespace := c.Param("namespace")
+	name := c.Param("service")
+	keys := c.QueryArray("key")
+	event := &audit.Event{
+		Timestamp: time.Now(),
+		Verb:      audit.VerbReveal,
+		Method:    c.Request.Method,
+		Path:      c.Request.URL.Path,
+		SourceIP:  c.ClientIP(),
+		Resource:  "secret",
+		Namespace: namespace,
+		Name:      name,
+		Result:    audit.ResultFailure,
+	}
+	defer func() {
+		event.LatencyMs = time.Since(event.Timestamp).Milliseconds()
+		if userInfo, err := client.GetUserInfo(c.Request); err == nil {
+			event.User = userInfo.Username
+			event.Groups = userInfo.Groups
+		}
+		klog.InfoS("Secret reveal", "user", event.User, "namespace", namespace, "name", name, "keys", keys, "result", event.Result)
+		audit.Record(event)
+	}()
+
+	allowed, err := client.IsAllowed(c.Request, &authorizationv1.ResourceAttributes{
+		Verb:      "get",
+		Resource:  "secrets",
+		Namespace: namespace,
+		Name:      name,
+	})
+	if err != nil {
+		event.Message = err.Error()
+		common.Fail(c, err)
+		return
+	}
+	if !allowed {
+		err = errors.NewForbidden(name, fmt.Errorf("not allowed to get secret %s/%s", namespace, name))
+		event.Message = err.Error()
+		common.Fail(c, err)
+		return
+	}
+	k8sClient, err := client.GetKubeClientFromRequest(c.Request)
+	if err != nil {
+		event.Message = err.Error()
+		common.Fail(c, err)
+		return
+	}
+	result, err := secret.RevealSecretData(k8sClient, namespace, name, keys)
+	if err != nil {
+		event.Message = err.Error()
+		common.Fail(c, err)
+		return
+	}
+	revealed := make([]string, 0, len(result.Data))
+	for key := range result.Data {
+		revealed = append(revealed, key)
+	}
+	event.Result = audit.ResultSuccess
+	event.Message = "keys: " + strings.Join(revealed, ",")
+	common.Success(c, result)
+}
+
 func init() {
 	r := router.V1()
 	r.GET("/secret", handleGetSecrets)
 	r.GET("/secret/:namespace", handleGetSecrets)
 	r.GET("/secret/:namespace/:service", handleGetSecretDetail)
+	r.GET("/secret/:namespace/:service/reveal", handleRevealSecret)
 }
@@ -26,9 +26,21 @@ import (
 )
 
 func handleGetServices(c *gin.Context) {
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
-	dataSelect := common.ParseDataSelectPathParameter(c)
-	nsQuery := common.ParseNamespacePathParameter(c)
+	k8sClient, err := client.GetCachedKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
+	nsQuery, err := common.ParseNamespacePathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	result, err := service.GetServiceList(k8sClient, nsQuery, dataSelect)
 	if err != nil {
 		common.Fail(c, err)
@@ -38,7 +50,11 @@ func handleGetServices(c *gin.Context) {
 }
 
 func handleGetServiceDetail(c *gin.Context) {
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	k8sClient, err := client.GetCachedKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	namespace := c.Param("namespace")
 	name := c.Param("service")
 	result, err := service.GetServiceDetail(k8sClient, namespace, name)
@@ -50,10 +66,18 @@ func handleGetServiceDetail(c *gin.Context) {
 }
 
 func handleGetServiceEvents(c *gin.Context) {
-	k8sClient := client.InClusterClientForKarmadaAPIServer()
+	k8sClient, err := client.GetKubeClientFromRequest(c.Request)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	namespace := c.Param("namespace")
 	name := c.Param("service")
-	dataSelect := common.ParseDataSelectPathParameter(c)
+	dataSelect, err := common.ParseDataSelectPathParameter(c)
+	if err != nil {
+		common.Fail(c, err)
+		return
+	}
 	result, err := service.GetServiceEvents(k8sClient, dataSelect, namespace, name)
 	if err != nil {
 		common.Fail(c, err)
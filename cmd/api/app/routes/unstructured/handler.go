@@ -28,6 +28,7 @@ import (
 	"github.com/karmada-io/dashboard/cmd/api/app/router"
 	"github.com/karmada-io/dashboard/cmd/api/app/types/common"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/resource/secret"
 )
 
 func handleDeleteResource(c *gin.Context) {
@@ -81,6 +82,10 @@ func handleGetResource(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	// secret values are only handed out by the audited reveal endpoint
+	if obj, ok := result.(*unstructured.Unstructured); ok {
+		secret.RedactUnstructured(obj)
+	}
 	common.Success(c, result)
 }
 func handlePutResource(c *gin.Context) {
@@ -104,6 +109,18 @@ func handlePutResource(c *gin.Context) {
 		common.Fail(c, err)
 		return
 	}
+	if secret.IsSecret(raw) {
+		// values which were redacted on read and left alone by the user keep their stored value
+		current, err := verber.Get("secret", raw.GetNamespace(), raw.GetName())
+		if err != nil {
+			klog.ErrorS(err, "Failed to get secret")
+			common.Fail(c, err)
+			return
+		}
+		if currentObj, ok := current.(*unstructured.Unstructured); ok {
+			secret.RestoreRedacted(raw, currentObj)
+		}
+	}
 	if err = verber.Update(raw); err != nil {
 		klog.ErrorS(err, "Failed to update resource")
 		common.Fail(c, err)
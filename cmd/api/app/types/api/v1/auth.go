@@ -16,6 +16,8 @@ limitations under the License.
 
 package v1
 
+import "time"
+
 // LoginRequest is the request for login.
 type LoginRequest struct {
 	Token string `json:"token"`
@@ -23,13 +25,48 @@ type LoginRequest struct {
 
 // LoginResponse is the response for login.
 type LoginResponse struct {
-	Token string `json:"token"`
+	// Token is only returned if server-side sessions are disabled, otherwise the session cookie is used.
+	Token string `json:"token,omitempty"`
+	// RefreshToken is only set for OIDC logins without server-side sessions.
+	RefreshToken string `json:"refreshToken,omitempty"`
+	// ExpiresAt is the expiry of Token in unix seconds, it is only set for OIDC logins.
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+}
+
+// OIDCLoginResponse is the response for starting an OIDC login.
+type OIDCLoginResponse struct {
+	// AuthCodeURL is the url of the identity provider the browser has to be redirected to.
+	AuthCodeURL string `json:"authCodeURL"`
+}
+
+// OIDCCallbackRequest is the request for finishing an OIDC login.
+type OIDCCallbackRequest struct {
+	Code  string `json:"code" form:"code" binding:"required"`
+	State string `json:"state" form:"state" binding:"required"`
+}
+
+// OIDCRefreshRequest is the request for refreshing an OIDC login.
+type OIDCRefreshRequest struct {
+	// RefreshToken can be left empty to refresh the token of the current session.
+	RefreshToken string `json:"refreshToken"`
+}
+
+// SessionInfo describes a login session of the current user.
+type SessionInfo struct {
+	// Handle identifies the session when revoking it.
+	Handle    string    `json:"handle"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	// Current is true for the session of the request.
+	Current bool `json:"current"`
 }
 
 // User is the user info.
 type User struct {
 	Name          string `json:"name,omitempty"`
 	Authenticated bool   `json:"authenticated"`
+	// Roles are the dashboard roles of the user, empty if dashboard RBAC is disabled.
+	Roles []string `json:"roles,omitempty"`
 }
 
 // ServiceAccount is the service account info.
@@ -37,3 +74,8 @@ type ServiceAccount struct {
 	Name string `json:"name"`
 	UID  string `json:"uid"`
 }
+
+// CSRFTokenResponse carries the token to send in the X-CSRF-TOKEN header of mutating requests.
+type CSRFTokenResponse struct {
+	Token string `json:"token"`
+}
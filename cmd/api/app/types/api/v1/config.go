@@ -23,4 +23,16 @@ type SetDashboardConfigRequest struct {
 	DockerRegistries []config.DockerRegistry `json:"docker_registries"`
 	ChartRegistries  []config.ChartRegistry  `json:"chart_registries"`
 	MenuConfigs      []config.MenuConfig     `json:"menu_configs"`
+	Roles            []config.Role           `json:"roles"`
+	RoleBindings     []config.RoleBinding    `json:"role_bindings"`
+	DefaultRole      *string                 `json:"default_role"`
+	// ResourceVersion of the config the changes are based on, the update is rejected if the config
+	// changed since. Empty skips the check.
+	ResourceVersion string `json:"resource_version"`
+}
+
+// RollbackDashboardConfigRequest is the request for restoring a previous revision of the dashboard config
+type RollbackDashboardConfigRequest struct {
+	Revision        int64  `json:"revision" binding:"required"`
+	ResourceVersion string `json:"resource_version"`
 }
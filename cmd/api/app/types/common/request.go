@@ -17,32 +17,57 @@ limitations under the License.
 package common
 
 import (
+	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 	"github.com/karmada-io/dashboard/pkg/resource/common"
 )
 
-func parsePaginationPathParameter(request *gin.Context) *dataselect.PaginationQuery {
+func parsePaginationPathParameter(request *gin.Context) (*dataselect.PaginationQuery, error) {
+	if limit := request.Query("limit"); limit != "" {
+		// Cursor pagination is opt-in, page numbers are ignored with it
+		parsedLimit, err := strconv.ParseInt(limit, 10, 64)
+		if err != nil || parsedLimit <= 0 {
+			return nil, errors.NewBadRequest("limit must be a positive number")
+		}
+		return dataselect.NewCursorPaginationQuery(parsedLimit, request.Query("continue")), nil
+	}
+	if request.Query("continue") != "" {
+		return nil, errors.NewBadRequest("continue requires limit")
+	}
+
 	itemsPerPage, err := strconv.ParseInt(request.Query("itemsPerPage"), 10, 0)
 	if err != nil {
-		return dataselect.NoPagination
+		return dataselect.NoPagination, nil
 	}
 
 	page, err := strconv.ParseInt(request.Query("page"), 10, 0)
 	if err != nil {
-		return dataselect.NoPagination
+		return dataselect.NoPagination, nil
 	}
 
 	// Frontend pages start from 1 and backend starts from 0
-	return dataselect.NewPaginationQuery(int(itemsPerPage), int(page-1))
+	return dataselect.NewPaginationQuery(int(itemsPerPage), int(page-1)), nil
 }
 
-func parseFilterPathParameter(request *gin.Context) *dataselect.FilterQuery {
-	return dataselect.NewFilterQuery(strings.Split(request.Query("filterBy"), ","))
+// Parses the filterBy, labelSelector and fieldSelector query parameters of the request
+func parseFilterPathParameter(request *gin.Context) (*dataselect.FilterQuery, error) {
+	filterQuery, err := dataselect.ParseFilterQuery(strings.Split(request.Query("filterBy"), ","))
+	if err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid filterBy: %v", err))
+	}
+	filterQuery, err = filterQuery.WithSelectors(request.Query("labelSelector"), request.Query("fieldSelector"))
+	if err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid selector: %v", err))
+	}
+	return filterQuery, nil
 }
 
 // Parses query parameters of the request and returns a SortQuery object
@@ -50,18 +75,40 @@ func parseSortPathParameter(request *gin.Context) *dataselect.SortQuery {
 	return dataselect.NewSortQuery(strings.Split(request.Query("sortBy"), ","))
 }
 
-// ParseDataSelectPathParameter parses query parameters of the request and returns a DataSelectQuery object
-func ParseDataSelectPathParameter(request *gin.Context) *dataselect.DataSelectQuery {
-	paginationQuery := parsePaginationPathParameter(request)
+// ParseDataSelectPathParameter parses query parameters of the request and returns a DataSelectQuery object.
+// Invalid label or field selectors are bad requests, as is cursor pagination, which is only supported by
+// the lists parsing their query with ParseCursorDataSelectPathParameter.
+func ParseDataSelectPathParameter(request *gin.Context) (*dataselect.DataSelectQuery, error) {
+	if request.Query("limit") != "" || request.Query("continue") != "" {
+		return nil, errors.NewBadRequest("cursor pagination with limit and continue is not supported by this list")
+	}
+	return ParseCursorDataSelectPathParameter(request)
+}
+
+// ParseCursorDataSelectPathParameter is ParseDataSelectPathParameter for lists supporting cursor
+// pagination with the limit and continue parameters, see dataselect.GenericDataSelectWithCursor.
+func ParseCursorDataSelectPathParameter(request *gin.Context) (*dataselect.DataSelectQuery, error) {
+	paginationQuery, err := parsePaginationPathParameter(request)
+	if err != nil {
+		return nil, err
+	}
 	sortQuery := parseSortPathParameter(request)
-	filterQuery := parseFilterPathParameter(request)
-	return dataselect.NewDataSelectQuery(paginationQuery, sortQuery, filterQuery)
+	filterQuery, err := parseFilterPathParameter(request)
+	if err != nil {
+		return nil, err
+	}
+	return dataselect.NewDataSelectQuery(paginationQuery, sortQuery, filterQuery), nil
 }
 
 // ParseNamespacePathParameter parses namespace selector for list pages in path parameter.
 // The namespace selector is a comma separated list of namespaces that are trimmed.
 // No namespaces mean "view all user namespaces", i.e., everything except kube-system.
-func ParseNamespacePathParameter(request *gin.Context) *common.NamespaceQuery {
+// With namespace tenancy the query is restricted to the namespaces the user can see.
+func ParseNamespacePathParameter(request *gin.Context) (*common.NamespaceQuery, error) {
+	return RestrictNamespaceQuery(request.Request, common.NewNamespaceQuery(parseNamespaces(request)))
+}
+
+func parseNamespaces(request *gin.Context) []string {
 	namespace := request.Param("namespace")
 	namespaces := strings.Split(namespace, ",")
 	var nonEmptyNamespaces []string
@@ -71,5 +118,32 @@ func ParseNamespacePathParameter(request *gin.Context) *common.NamespaceQuery {
 			nonEmptyNamespaces = append(nonEmptyNamespaces, n)
 		}
 	}
-	return common.NewNamespaceQuery(nonEmptyNamespaces)
+	return nonEmptyNamespaces
+}
+
+// ParseMemberNamespacePathParameter is ParseNamespacePathParameter for the lists of the member cluster
+// of the clustername path parameter, restricted to the namespaces the user can see in that cluster.
+func ParseMemberNamespacePathParameter(request *gin.Context) (*common.NamespaceQuery, error) {
+	nsQuery := common.NewNamespaceQuery(parseNamespaces(request))
+	visibility, err := client.GetVisibleMemberNamespaces(request.Request, request.Param("clustername"))
+	if err != nil {
+		return nil, err
+	}
+	if visibility.All {
+		return nsQuery, nil
+	}
+	return nsQuery.Restrict(visibility.Namespaces), nil
+}
+
+// RestrictNamespaceQuery limits the query to the namespaces the user of the request can see. It fails if
+// they can not be determined.
+func RestrictNamespaceQuery(request *http.Request, nsQuery *common.NamespaceQuery) (*common.NamespaceQuery, error) {
+	visibility, err := client.GetVisibleNamespaces(request)
+	if err != nil {
+		return nil, err
+	}
+	if visibility.All {
+		return nsQuery, nil
+	}
+	return nsQuery.Restrict(visibility.Namespaces), nil
 }
@@ -0,0 +1,58 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestParseDataSelectPathParameter(t *testing.T) {
+	tests := []struct {
+		query     string
+		cursor    bool
+		wantErr   bool
+		wantLimit int64
+	}{
+		{query: "page=2&itemsPerPage=10"},
+		{query: "limit=10", wantErr: true},
+		{query: "continue=abc", wantErr: true},
+		{query: "limit=10&continue=abc", cursor: true, wantLimit: 10},
+		{query: "limit=0", cursor: true, wantErr: true},
+		{query: "limit=ten", cursor: true, wantErr: true},
+		{query: "continue=abc", cursor: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/pod?"+tt.query, nil)
+		parse := ParseDataSelectPathParameter
+		if tt.cursor {
+			parse = ParseCursorDataSelectPathParameter
+		}
+		dsQuery, err := parse(c)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s (cursor %v): expected error %v, got %v", tt.query, tt.cursor, tt.wantErr, err)
+			continue
+		}
+		if err == nil && dsQuery.PaginationQuery.Limit != tt.wantLimit {
+			t.Errorf("%s (cursor %v): expected limit %d, got %d", tt.query, tt.cursor, tt.wantLimit, dsQuery.PaginationQuery.Limit)
+		}
+	}
+}
@@ -18,15 +18,26 @@ package common
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/errors"
 )
 
+// CacheFreshnessHeader is set on responses with data read from the resource cache, its RFC 3339 time
+// is the moment up to which all of that data is known to be current.
+const CacheFreshnessHeader = "X-Cache-Freshness"
+
 // BaseResponse is the base response
 type BaseResponse struct {
+	// Code is 200 on success and 500 otherwise, the HTTP status of the error is Error.Status.
 	Code int         `json:"code"`
 	Msg  string      `json:"message"`
 	Data interface{} `json:"data"`
+	// Error is the structured error, set if the request failed.
+	Error *errors.APIError `json:"error,omitempty"`
 }
 
 // Success generate success response
@@ -39,17 +50,47 @@ func Fail(c *gin.Context, err error) {
 	Response(c, err, nil)
 }
 
-// Response generate response
+// V2ErrorResponse is the body of failed v2 responses.
+type V2ErrorResponse struct {
+	Error *errors.APIError `json:"error"`
+}
+
+// Response generate response. The v1 responses answer with 200 and the BaseResponse envelope, the v2
+// responses, see IsV2, answer with the data itself or a V2ErrorResponse and the HTTP status of the error.
 func Response(c *gin.Context, err error, data interface{}) {
+	if freshness, ok := client.CacheFreshness(c.Request.Context()); ok {
+		c.Header(CacheFreshnessHeader, freshness.UTC().Format(time.RFC3339))
+	}
+	if IsV2(c) {
+		responseV2(c, err, data)
+		return
+	}
 	code := 200          // biz status code
 	message := "success" // biz status message
+	var apiError *errors.APIError
 	if err != nil {
+		// clients sending Accept-Language get the error codes translated
+		apiError = errors.NewAPIError(err).Localize(c.GetHeader("Accept-Language"))
 		code = 500
-		message = err.Error()
+		message = apiError.Message
+		// keep the error on the context for middlewares, e.g. the audit log
+		_ = c.Error(err)
 	}
 	c.JSON(http.StatusOK, BaseResponse{
-		Code: code,
-		Msg:  message,
-		Data: data,
+		Code:  code,
+		Msg:   message,
+		Data:  data,
+		Error: apiError,
 	})
 }
+
+func responseV2(c *gin.Context, err error, data interface{}) {
+	if err == nil {
+		c.JSON(http.StatusOK, data)
+		return
+	}
+	apiError := errors.NewAPIError(err).Localize(c.GetHeader("Accept-Language"))
+	apiError.Status, _ = errors.HandleError(err)
+	_ = c.Error(err)
+	c.JSON(apiError.Status, V2ErrorResponse{Error: apiError})
+}
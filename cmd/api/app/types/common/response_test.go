@@ -0,0 +1,68 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+func respond(req *http.Request, err error, data interface{}) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	Response(c, err, data)
+	return w
+}
+
+func TestResponse(t *testing.T) {
+	notFound := k8serrors.NewNotFound(schema.GroupResource{Resource: "clusters"}, "member1")
+
+	w := respond(httptest.NewRequest(http.MethodGet, "/api/v1/cluster/member1", nil), notFound, nil)
+	var v1Response BaseResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &v1Response); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusOK || v1Response.Code != http.StatusInternalServerError ||
+		v1Response.Error.Status != http.StatusNotFound || v1Response.Error.Code != errors.MsgNotFoundError {
+		t.Errorf("expected the v1 envelope with status 200, got %d and %s", w.Code, w.Body.String())
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/cluster/member1", nil)
+	req.Header.Set("Accept", "application/json, "+V2MediaType+";q=0.9")
+	w = respond(req, notFound, nil)
+	var v2Response V2ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &v2Response); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusNotFound || v2Response.Error.Code != errors.MsgNotFoundError || v2Response.Error.Params["name"] != "member1" {
+		t.Errorf("expected a v2 error with status 404, got %d and %s", w.Code, w.Body.String())
+	}
+
+	w = respond(WithV2(httptest.NewRequest(http.MethodGet, "/api/v1/cluster", nil)), nil, map[string]int{"total": 1})
+	if w.Code != http.StatusOK || w.Body.String() != `{"total":1}` {
+		t.Errorf("expected the data without envelope, got %d and %s", w.Code, w.Body.String())
+	}
+}
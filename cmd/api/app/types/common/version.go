@@ -0,0 +1,49 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// V2MediaType is the media type of the v2 responses, requests accepting it get v2 responses on /api/v1 too.
+const V2MediaType = "application/vnd.karmada.dashboard.v2+json"
+
+type v2Key struct{}
+
+// WithV2 marks the request for v2 responses, e.g. because it was sent to /api/v2.
+func WithV2(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), v2Key{}, true))
+}
+
+// IsV2 returns whether the request wants v2 responses, see Response.
+func IsV2(c *gin.Context) bool {
+	if v2, _ := c.Request.Context().Value(v2Key{}).(bool); v2 {
+		return true
+	}
+	for _, accepted := range strings.Split(c.GetHeader("Accept"), ",") {
+		mediaType, _, _ := strings.Cut(accepted, ";")
+		if strings.EqualFold(strings.TrimSpace(mediaType), V2MediaType) {
+			return true
+		}
+	}
+	return false
+}
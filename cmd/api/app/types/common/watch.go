@@ -0,0 +1,101 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	rescommon "github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+// watchHeartbeatInterval keeps idle streams from being closed by proxies.
+const watchHeartbeatInterval = 30 * time.Second
+
+// IsWatch returns true if the list request asks for a stream of changes with ?watch=true.
+func IsWatch(c *gin.Context) bool {
+	return c.Query("watch") == "true"
+}
+
+// ParseResourceVersion returns the resource version a watch resumes from. The Last-Event-ID header
+// browsers send when they reconnect an EventSource wins over the resourceVersion query parameter.
+func ParseResourceVersion(c *gin.Context) string {
+	if lastEventID := c.GetHeader("Last-Event-ID"); lastEventID != "" {
+		return lastEventID
+	}
+	return c.Query("resourceVersion")
+}
+
+// StreamWatch streams the events of the list watch as Server-Sent Events until the client goes away or
+// the watch ends. The event name is the event type, e.g. ADDED or RESET, the id the resource version to
+// resume from and the data the list item, or the structured error of ERROR events.
+func StreamWatch(c *gin.Context, watcher *rescommon.ListWatch) {
+	defer watcher.Stop()
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(watchHeartbeatInterval)
+	defer heartbeat.Stop()
+	acceptLanguage := c.GetHeader("Accept-Language")
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := io.WriteString(c.Writer, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return
+			}
+			if err := writeWatchEvent(c.Writer, event, acceptLanguage); err != nil {
+				klog.V(4).InfoS("Failed to write watch event", "err", err)
+				return
+			}
+		}
+		c.Writer.Flush()
+	}
+}
+
+func writeWatchEvent(w io.Writer, event rescommon.WatchEvent, acceptLanguage string) error {
+	data := event.Object
+	if event.Error != nil {
+		data = errors.NewAPIError(event.Error).Localize(acceptLanguage)
+	}
+	buff, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if event.ResourceVersion != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", event.ResourceVersion); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, buff)
+	return err
+}
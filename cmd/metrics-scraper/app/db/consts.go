@@ -17,7 +17,7 @@ limitations under the License.
 package db
 
 const (
-	// Namespace is the namespace of karmada.
+	// Namespace is the default namespace of karmada, see the --karmada-namespace flag of the scraper.
 	Namespace = "karmada-system"
 	// KarmadaAgent is the name of karmada agent.
 	KarmadaAgent = "karmada-agent"
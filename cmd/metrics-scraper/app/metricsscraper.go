@@ -31,8 +31,10 @@ import (
 	"github.com/karmada-io/dashboard/cmd/metrics-scraper/app/routes/metrics"
 	"github.com/karmada-io/dashboard/cmd/metrics-scraper/app/scrape"
 	"github.com/karmada-io/dashboard/pkg/client"
+	"github.com/karmada-io/dashboard/pkg/common/middleware"
 	"github.com/karmada-io/dashboard/pkg/config"
 	"github.com/karmada-io/dashboard/pkg/environment"
+	"github.com/karmada-io/dashboard/pkg/features"
 )
 
 // NewMetricsScraperCommand creates a *cobra.Command object with default parameters
@@ -88,9 +90,10 @@ func run(ctx context.Context, opts *options.Options) error {
 	)
 	ensureAPIServerConnectionOrDie()
 	serve(opts)
+	scrape.SetKarmadaNamespace(opts.KarmadaNamespace)
 	go scrape.InitDatabase()
 
-	config.InitDashboardConfig(client.InClusterClient(), ctx.Done())
+	config.InitDashboardConfig(client.InClusterClient(), config.ConfigMapRef{Namespace: opts.Namespace, Name: opts.ConfigName}, ctx.Done())
 	<-ctx.Done()
 	os.Exit(0)
 	return nil
@@ -123,7 +126,7 @@ func ensureAPIServerConnectionOrDie() {
 }
 
 func init() {
-	r := router.V1()
+	r := router.V1().Group("", middleware.FeatureGate(features.MetricsScraper, router.Fail))
 	r.GET("/metrics", metrics.GetMetrics)
 	r.GET("/metrics/:app_name", metrics.GetMetrics)
 	r.GET("/metrics/:app_name/:pod_name", metrics.QueryMetrics)
@@ -20,6 +20,8 @@ import (
 	"net"
 
 	"github.com/spf13/pflag"
+
+	"github.com/karmada-io/dashboard/pkg/config"
 )
 
 // Options contains everything necessary to create and run api.
@@ -35,6 +37,8 @@ type Options struct {
 	KarmadaContext                string
 	SkipKarmadaApiserverTLSVerify bool
 	Namespace                     string
+	ConfigName                    string
+	KarmadaNamespace              string
 	DisableCSRFProtection         bool
 	OpenAPIEnabled                bool
 }
@@ -59,7 +63,9 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.KarmadaKubeConfig, "karmada-kubeconfig", "", "Path to the karmada control plane kubeconfig file.")
 	fs.StringVar(&o.KarmadaContext, "karmada-context", "", "The name of the karmada-kubeconfig context to use.")
 	fs.BoolVar(&o.SkipKarmadaApiserverTLSVerify, "skip-karmada-apiserver-tls-verify", false, "enable if connection with remote Karmada API server should skip TLS verify")
-	fs.StringVar(&o.Namespace, "namespace", "karmada-dashboard", "Namespace to use when accessing Dashboard specific resources, i.e. configmap")
+	fs.StringVar(&o.Namespace, "namespace", "karmada-system", "Namespace to use when accessing Dashboard specific resources, i.e. configmap, the --namespace of the api")
+	fs.StringVar(&o.ConfigName, "config-name", config.DefaultConfigName, "name of the dashboard configmap in --namespace")
+	fs.StringVar(&o.KarmadaNamespace, "karmada-namespace", "karmada-system", "namespace of the host cluster the karmada components are scraped in")
 	fs.BoolVar(&o.DisableCSRFProtection, "disable-csrf-protection", false, "allows disabling CSRF protection")
 	fs.BoolVar(&o.OpenAPIEnabled, "openapi-enabled", false, "enables OpenAPI v2 endpoint under '/apidocs.json'")
 }
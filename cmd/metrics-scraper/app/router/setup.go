@@ -19,6 +19,7 @@ package router
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/karmada-io/dashboard/pkg/common/errors"
 	"github.com/karmada-io/dashboard/pkg/environment"
 )
 
@@ -53,3 +54,8 @@ func V1() *gin.RouterGroup {
 func Router() *gin.Engine {
 	return router
 }
+
+// Fail answers the request with the HTTP status and message of err, e.g. for middleware.FeatureGate.
+func Fail(c *gin.Context, err error) {
+	c.JSON(errors.NewAPIError(err).Status, gin.H{"error": err.Error()})
+}
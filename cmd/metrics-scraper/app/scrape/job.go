@@ -78,7 +78,7 @@ func FetchMetrics(ctx context.Context, appName string, requests chan SaveRequest
 						port = db.ControllerManagerPort
 					}
 					metricsOutput, err := kubeClient.CoreV1().RESTClient().Get().
-						Namespace(db.Namespace).
+						Namespace(karmadaNamespace).
 						Resource("pods").
 						SubResource("proxy").
 						Name(fmt.Sprintf("%s:%s", pod.Name, port)).
@@ -143,7 +143,7 @@ func getKarmadaPods(ctx context.Context, appName string) (map[string][]db.PodInf
 			}
 		}
 	} else {
-		pods, err := kubeClient.CoreV1().Pods(db.Namespace).List(ctx, metav1.ListOptions{
+		pods, err := kubeClient.CoreV1().Pods(karmadaNamespace).List(ctx, metav1.ListOptions{
 			LabelSelector: fmt.Sprintf("app=%s", appName),
 		})
 		if err != nil {
@@ -28,6 +28,8 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/karmada-io/dashboard/cmd/metrics-scraper/app/db"
+	"github.com/karmada-io/dashboard/pkg/config"
+	"github.com/karmada-io/dashboard/pkg/features"
 )
 
 var (
@@ -38,8 +40,16 @@ var (
 	appContexts    map[string]context.Context
 	appCancelFuncs map[string]context.CancelFunc
 	contextMutex   sync.Mutex
+	// karmadaNamespace is the namespace of the host cluster the karmada components run in
+	karmadaNamespace = db.Namespace
 )
 
+// SetKarmadaNamespace sets the namespace the karmada component pods are scraped in, it must be
+// called before InitDatabase.
+func SetKarmadaNamespace(namespace string) {
+	karmadaNamespace = namespace
+}
+
 func startAppMetricsFetcher(appName string) {
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
@@ -59,6 +69,10 @@ func startAppMetricsFetcher(appName string) {
 			log.Printf("Stopping metrics fetcher for %s", appName)
 			return
 		case <-ticker.C:
+			// the fetcher stays registered while the feature is disabled, so it resumes once re-enabled
+			if !config.FeatureEnabled(features.MetricsScraper) {
+				continue
+			}
 			syncTriggerVal, ok := syncMap.Load(appName)
 			if !ok {
 				continue
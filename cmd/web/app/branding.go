@@ -0,0 +1,127 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/config"
+)
+
+const (
+	brandingAssetLogo    = "logo"
+	brandingAssetFavicon = "favicon"
+)
+
+// brandingResponse is the branding handed to the UI, assets are replaced by the URLs they are served at.
+type brandingResponse struct {
+	ProductName string               `json:"product_name"`
+	LogoURL     string               `json:"logo_url,omitempty"`
+	FaviconURL  string               `json:"favicon_url,omitempty"`
+	Theme       config.BrandingTheme `json:"theme"`
+	LoginBanner string               `json:"login_banner,omitempty"`
+	FooterLinks []config.FooterLink  `json:"footer_links,omitempty"`
+}
+
+func brandingAsset(branding *config.Branding, name string) *config.BrandingAsset {
+	if branding == nil {
+		return nil
+	}
+	switch name {
+	case brandingAssetLogo:
+		return branding.Logo
+	case brandingAssetFavicon:
+		return branding.Favicon
+	default:
+		return nil
+	}
+}
+
+// newBrandingResponse builds the branding of the config, asset URLs carry the config generation so that
+// browsers fetch replaced logos.
+func newBrandingResponse(dashboardConfig config.DashboardConfig, pathPrefix string, generation int64) brandingResponse {
+	response := brandingResponse{ProductName: config.DefaultProductName}
+	branding := dashboardConfig.Branding
+	if branding == nil {
+		return response
+	}
+	if branding.ProductName != "" {
+		response.ProductName = branding.ProductName
+	}
+	assetURL := func(name string) string {
+		if brandingAsset(branding, name) == nil {
+			return ""
+		}
+		return fmt.Sprintf("%s/branding/%s?v=%d", pathPrefix, name, generation)
+	}
+	response.LogoURL = assetURL(brandingAssetLogo)
+	response.FaviconURL = assetURL(brandingAssetFavicon)
+	response.Theme = branding.Theme
+	response.LoginBanner = branding.LoginBanner
+	response.FooterLinks = branding.FooterLinks
+	return response
+}
+
+// renderIndexHTML fills the placeholders of index.html: {{PathPrefix}}, {{ProductName}} and {{Branding}},
+// the branding as JSON for a <script type="application/json"> element.
+func renderIndexHTML(indexHTML string, branding brandingResponse, pathPrefix string) string {
+	// json.Marshal escapes <, > and &, the JSON can not end the script element
+	brandingJSON, err := json.Marshal(branding)
+	if err != nil {
+		klog.ErrorS(err, "Failed to marshal branding")
+		brandingJSON = []byte("{}")
+	}
+	return strings.NewReplacer(
+		"{{PathPrefix}}", pathPrefix,
+		"{{ProductName}}", html.EscapeString(branding.ProductName),
+		"{{Branding}}", string(brandingJSON),
+	).Replace(indexHTML)
+}
+
+// handleGetBranding returns the branding of the current config.
+func handleGetBranding(prefix *prefixHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, newBrandingResponse(config.GetDashboardConfig(), prefix.getPathPrefix(), config.GetStatus().Generation))
+	}
+}
+
+// handleGetBrandingAsset serves the logo and favicon from the ConfigMaps and Secrets mounted below brandingDir.
+func handleGetBrandingAsset(brandingDir string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		asset := brandingAsset(config.GetDashboardConfig().Branding, c.Param("asset"))
+		if asset == nil {
+			c.Status(http.StatusNotFound)
+			return
+		}
+		assetPath, err := asset.Path(brandingDir)
+		if err != nil {
+			klog.ErrorS(err, "Invalid branding asset", "asset", c.Param("asset"))
+			c.Status(http.StatusNotFound)
+			return
+		}
+		// the URLs carry the config generation, the files may still change in place
+		c.Header("Cache-Control", "no-cache")
+		c.File(assetPath)
+	}
+}
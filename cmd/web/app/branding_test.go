@@ -0,0 +1,53 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/karmada-io/dashboard/pkg/config"
+)
+
+func TestRenderIndexHTML(t *testing.T) {
+	dashboardConfig := config.DashboardConfig{Branding: &config.Branding{
+		ProductName: "Acme <Fleet>",
+		Logo:        &config.BrandingAsset{ConfigMap: "acme", Key: "logo.svg"},
+		LoginBanner: "</script><script>alert(1)</script>",
+	}}
+	branding := newBrandingResponse(dashboardConfig, "/dashboard", 3)
+	if branding.LogoURL != "/dashboard/branding/logo?v=3" || branding.FaviconURL != "" {
+		t.Errorf("unexpected asset URLs %q and %q", branding.LogoURL, branding.FaviconURL)
+	}
+
+	rendered := renderIndexHTML(
+		`<title>{{ProductName}}</title><script id="branding" type="application/json">{{Branding}}</script><script>p='{{PathPrefix}}'</script>`,
+		branding, "/dashboard")
+	if !strings.Contains(rendered, "<title>Acme &lt;Fleet&gt;</title>") {
+		t.Errorf("expected an escaped title, got %s", rendered)
+	}
+	if strings.Count(rendered, "</script>") != 2 {
+		t.Errorf("expected the branding JSON not to close the script element, got %s", rendered)
+	}
+	if !strings.Contains(rendered, "p='/dashboard'") {
+		t.Errorf("expected the path prefix, got %s", rendered)
+	}
+
+	if defaults := newBrandingResponse(config.DashboardConfig{}, "", 1); defaults.ProductName != config.DefaultProductName {
+		t.Errorf("expected the default product name, got %q", defaults.ProductName)
+	}
+}
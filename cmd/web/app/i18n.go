@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/karmada-io/dashboard/pkg/i18n"
+)
+
+// handleGetI18nBundle serves the bundle of the locale in the path, e.g. /i18n/zh-CN.json, or of the
+// Accept-Language header for /i18n/. Unknown locales fall back to the best match of the header.
+func handleGetI18nBundle(bundles *i18n.Bundles) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		preferred := i18n.ParseAcceptLanguage(c.GetHeader("Accept-Language"))
+		if locale := strings.TrimSuffix(strings.Trim(c.Param("path"), "/"), ".json"); locale != "" {
+			preferred = append([]string{locale}, preferred...)
+		}
+		bundle, ok := bundles.Get(preferred)
+		if !ok {
+			c.JSON(http.StatusNotFound, gin.H{"error": "no i18n bundles found"})
+			return
+		}
+		c.Header("Vary", "Accept-Language")
+		c.Header("Content-Language", bundle.Locale)
+		c.Header("Cache-Control", "no-cache")
+		// ServeContent answers If-None-Match with 304 Not Modified
+		c.Header("ETag", bundle.ETag)
+		http.ServeContent(c.Writer, c.Request, bundle.Locale+".json", time.Time{}, bytes.NewReader(bundle.Data))
+	}
+}
@@ -28,11 +28,16 @@ type Options struct {
 	Port                int
 	InsecureBindAddress net.IP
 	InsecurePort        int
+	TLSCertFile         string
+	TLSKeyFile          string
+	TLSSelfSigned       bool
+	ClientCAFile        string
 	StaticDir           string
 	I18nDir             string
 	EnableAPIProxy      bool
 	APIProxyEndpoint    string
 	DashboardConfigPath string
+	BrandingDir         string
 }
 
 // NewOptions creates a new Options object with default parameters.
@@ -46,12 +51,17 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 		return
 	}
 	fs.IPVar(&o.BindAddress, "bind-address", net.IPv4(127, 0, 0, 1), "IP address on which to serve the --port, set to 0.0.0.0 for all interfaces")
-	fs.IntVar(&o.Port, "port", 8001, "secure port to listen to for incoming HTTPS requests")
+	fs.IntVar(&o.Port, "port", 8001, "secure port to listen to for incoming HTTPS requests, set to 0 to disable")
 	fs.IPVar(&o.InsecureBindAddress, "insecure-bind-address", net.IPv4(127, 0, 0, 1), "IP address on which to serve the --insecure-port, set to 0.0.0.0 for all interfaces")
-	fs.IntVar(&o.InsecurePort, "insecure-port", 8000, "port to listen to for incoming HTTP requests")
+	fs.IntVar(&o.InsecurePort, "insecure-port", 8000, "port to listen to for incoming HTTP requests, set to 0 to disable")
+	fs.StringVar(&o.TLSCertFile, "tls-cert-file", "", "file containing the x509 certificate for HTTPS, changes are reloaded. HTTPS is disabled if empty, unless --tls-self-signed is set")
+	fs.StringVar(&o.TLSKeyFile, "tls-private-key-file", "", "file containing the x509 private key matching --tls-cert-file")
+	fs.BoolVar(&o.TLSSelfSigned, "tls-self-signed", false, "serve HTTPS with a generated self-signed certificate if --tls-cert-file is empty, only meant for development")
+	fs.StringVar(&o.ClientCAFile, "client-ca-file", "", "if set, HTTPS clients must present a certificate signed by one of the authorities in this file")
 	fs.StringVar(&o.StaticDir, "static-dir", "./static", "directory to serve static files")
-	fs.StringVar(&o.I18nDir, "i18n-dir", "./i18n", "directory to serve i18n files")
+	fs.StringVar(&o.I18nDir, "i18n-dir", "./i18n", "directory with the locale bundles of the ui, named <locale>.json, changes are reloaded")
 	fs.BoolVar(&o.EnableAPIProxy, "enable-api-proxy", true, "whether enable proxy to karmada-dashboard-api, if set true, all requests with /api prefix will be proxyed to karmada-dashboard-api.karmada-system.svc.cluster.local")
 	fs.StringVar(&o.APIProxyEndpoint, "api-proxy-endpoint", "http://karmada-dashboard-api.karmada-system.svc.cluster.local:8000", "karmada-dashboard-api endpoint")
 	fs.StringVar(&o.DashboardConfigPath, "dashboard-config-path", "./config/dashboard-config.yaml", "path to dashboard config file")
+	fs.StringVar(&o.BrandingDir, "branding-dir", "./branding", "directory the ConfigMaps and Secrets with branding assets are mounted in, as configmaps/<name> and secrets/<name>")
 }
@@ -26,6 +26,8 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/karmada-io/karmada/pkg/sharedcli/klogflag"
@@ -37,6 +39,8 @@ import (
 	"github.com/karmada-io/dashboard/cmd/web/app/options"
 	"github.com/karmada-io/dashboard/pkg/config"
 	"github.com/karmada-io/dashboard/pkg/environment"
+	"github.com/karmada-io/dashboard/pkg/i18n"
+	"github.com/karmada-io/dashboard/pkg/serving"
 )
 
 // NewWebCommand creates a *cobra.Command object with default parameters
@@ -84,56 +88,113 @@ func run(ctx context.Context, opts *options.Options) error {
 	if err != nil {
 		return err
 	}
-	serve(opts)
+	config.WatchDashboardConfigFile(opts.DashboardConfigPath, configReloadInterval, ctx.Done())
+	bundles := i18n.NewBundles(opts.I18nDir, i18n.DefaultLocale)
+	if err := bundles.Load(); err != nil {
+		// the UI ships its own translations, the bundles only extend them
+		klog.ErrorS(err, "Failed to load i18n bundles", "dir", opts.I18nDir)
+	}
+	bundles.Watch(configReloadInterval, ctx.Done())
+	if err = serve(opts, bundles, ctx.Done()); err != nil {
+		return err
+	}
 	<-ctx.Done()
 	os.Exit(0)
 	return nil
 }
 
-func serve(opts *options.Options) {
-	insecureAddress := fmt.Sprintf("%s:%d", opts.InsecureBindAddress, opts.InsecurePort)
-	klog.V(1).InfoS("Listening and serving on", "address", insecureAddress)
-	pathPrefix := config.GetDashboardConfig().PathPrefix
-	klog.V(1).Infof("PathPrefix is:%s", pathPrefix)
-	go func() {
-		r := router.Router()
-		g := r.Group(pathPrefix)
-		g.StaticFS("/static", http.Dir(opts.StaticDir))
-		if opts.EnableAPIProxy {
-			//	https://karmada-apiserver.karmada-system.svc.cluster.local:5443
-			g.Any("/api/*path", func(c *gin.Context) {
-				remote, _ := url.Parse(opts.APIProxyEndpoint)
-				proxy := httputil.NewSingleHostReverseProxy(remote)
-				proxy.Director = func(req *http.Request) {
-					req.Header = c.Request.Header
-					req.Host = remote.Host
-					req.URL.Scheme = remote.Scheme
-					req.URL.Host = remote.Host
-					req.URL.Path = strings.TrimPrefix(req.URL.Path, pathPrefix)
-				}
-				proxy.ServeHTTP(c.Writer, c.Request)
-			})
+// configReloadInterval is how often the mounted dashboard config file and the i18n bundles are checked for changes.
+const configReloadInterval = 10 * time.Second
+
+// prefixHandler serves the dashboard below the PathPrefix of the current dashboard config, the
+// prefix follows config changes without a restart.
+type prefixHandler struct {
+	handler    http.Handler
+	pathPrefix atomic.Pointer[string]
+}
+
+func newPrefixHandler(handler http.Handler) *prefixHandler {
+	h := &prefixHandler{handler: handler}
+	h.setPathPrefix(config.GetDashboardConfig().PathPrefix)
+	config.Subscribe(func(dashboardConfig config.DashboardConfig) {
+		if dashboardConfig.PathPrefix != h.getPathPrefix() {
+			klog.InfoS("PathPrefix changed", "pathPrefix", dashboardConfig.PathPrefix)
+			h.setPathPrefix(dashboardConfig.PathPrefix)
 		}
-		// TODO:
-		// currently we only mock the return i18n json, this feature will be implemented by ospp2024
-		// https://summer-ospp.ac.cn/org/prodetail/245c40338?lang=zh&list=pro
-		g.GET("/i18n/*path", func(c *gin.Context) {
-			c.JSON(http.StatusOK, gin.H{})
-		})
-		r.NoRoute(func(c *gin.Context) {
-			indexHTML := "no content"
-			indexPath := path.Join(opts.StaticDir, "index.html")
-			f, err := os.Open(indexPath)
-			if err == nil {
-				buff, readAllErr := io.ReadAll(f)
-				if readAllErr == nil {
-					indexHTML = string(buff)
-					indexHTML = strings.ReplaceAll(indexHTML, "{{PathPrefix}}", pathPrefix)
-				}
+	})
+	return h
+}
+
+func (h *prefixHandler) setPathPrefix(pathPrefix string) {
+	pathPrefix = strings.TrimSuffix(pathPrefix, "/")
+	h.pathPrefix.Store(&pathPrefix)
+}
+
+func (h *prefixHandler) getPathPrefix() string {
+	return *h.pathPrefix.Load()
+}
+
+func (h *prefixHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	pathPrefix := h.getPathPrefix()
+	if pathPrefix != "" && (req.URL.Path == pathPrefix || strings.HasPrefix(req.URL.Path, pathPrefix+"/")) {
+		stripped := new(http.Request)
+		*stripped = *req
+		stripped.URL = new(url.URL)
+		*stripped.URL = *req.URL
+		stripped.URL.Path = strings.TrimPrefix(req.URL.Path, pathPrefix)
+		stripped.URL.RawPath = ""
+		if stripped.URL.Path == "" {
+			stripped.URL.Path = "/"
+		}
+		req = stripped
+	}
+	h.handler.ServeHTTP(w, req)
+}
+
+func serve(opts *options.Options, bundles *i18n.Bundles, stopCh <-chan struct{}) error {
+	klog.V(1).Infof("PathPrefix is:%s", config.GetDashboardConfig().PathPrefix)
+	r := router.Router()
+	prefix := newPrefixHandler(r.Handler())
+	g := r.Group("/")
+	g.StaticFS("/static", http.Dir(opts.StaticDir))
+	if opts.EnableAPIProxy {
+		//	https://karmada-apiserver.karmada-system.svc.cluster.local:5443
+		g.Any("/api/*path", func(c *gin.Context) {
+			remote, _ := url.Parse(opts.APIProxyEndpoint)
+			proxy := httputil.NewSingleHostReverseProxy(remote)
+			proxy.Director = func(req *http.Request) {
+				req.Header = c.Request.Header
+				req.Host = remote.Host
+				req.URL.Scheme = remote.Scheme
+				req.URL.Host = remote.Host
 			}
-			c.Header("Content-Type", "text/html; charset=utf-8")
-			c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(indexHTML))
+			proxy.ServeHTTP(c.Writer, c.Request)
 		})
-		klog.Fatal(router.Router().Run(insecureAddress))
-	}()
+	}
+	g.GET("/i18n/*path", handleGetI18nBundle(bundles))
+	g.GET("/branding", handleGetBranding(prefix))
+	g.GET("/branding/:asset", handleGetBrandingAsset(opts.BrandingDir))
+	r.NoRoute(func(c *gin.Context) {
+		indexHTML := "no content"
+		indexPath := path.Join(opts.StaticDir, "index.html")
+		f, err := os.Open(indexPath)
+		if err == nil {
+			buff, readAllErr := io.ReadAll(f)
+			if readAllErr == nil {
+				pathPrefix := prefix.getPathPrefix()
+				branding := newBrandingResponse(config.GetDashboardConfig(), pathPrefix, config.GetStatus().Generation)
+				indexHTML = renderIndexHTML(string(buff), branding, pathPrefix)
+			}
+		}
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(indexHTML))
+	})
+	return serving.Serve(prefix, serving.Config{
+		SecureAddress:   serving.Address(opts.BindAddress, opts.Port),
+		InsecureAddress: serving.Address(opts.InsecureBindAddress, opts.InsecurePort),
+		CertFile:        opts.TLSCertFile,
+		KeyFile:         opts.TLSKeyFile,
+		SelfSigned:      opts.TLSSelfSigned,
+		ClientCAFile:    opts.ClientCAFile,
+	}, stopCh)
 }
@@ -0,0 +1,101 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// ResultSuccess marks actions which completed.
+	ResultSuccess = "success"
+	// ResultFailure marks actions which were rejected or failed.
+	ResultFailure = "failure"
+
+	// VerbReveal marks reads of secret data, which are recorded although they do not mutate.
+	VerbReveal = "reveal"
+)
+
+// Event records a single mutating dashboard action, or the reveal of secret data.
+type Event struct {
+	Timestamp time.Time `json:"timestamp"`
+	// User and Groups are the identity the karmada apiserver authenticated the request as.
+	User   string   `json:"user"`
+	Groups []string `json:"groups,omitempty"`
+	// Verb is one of create, update, patch, delete or reveal.
+	Verb     string `json:"verb"`
+	Method   string `json:"method"`
+	Path     string `json:"path"`
+	SourceIP string `json:"sourceIP"`
+	// Cluster is set for actions on a member cluster.
+	Cluster   string `json:"cluster,omitempty"`
+	Resource  string `json:"resource"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	// RequestDigest is the sha256 of the request body, the body itself is not recorded.
+	RequestDigest string `json:"requestDigest,omitempty"`
+	Result        string `json:"result"`
+	// Message holds the error of failed actions.
+	Message   string `json:"message,omitempty"`
+	LatencyMs int64  `json:"latencyMs"`
+}
+
+// Sink receives audit events. Implementations must not block the request for long.
+type Sink interface {
+	Record(event *Event)
+}
+
+var (
+	sinksLock sync.RWMutex
+	sinks     []Sink
+	buffer    *RingBuffer
+)
+
+// Init sets the sinks events are recorded to. The ring buffer, if any, also serves GET /api/v1/audit.
+func Init(ringBuffer *RingBuffer, others ...Sink) {
+	sinksLock.Lock()
+	defer sinksLock.Unlock()
+	sinks = others
+	buffer = ringBuffer
+	if ringBuffer != nil {
+		sinks = append(sinks, ringBuffer)
+	}
+}
+
+// Enabled returns true if at least one sink is configured.
+func Enabled() bool {
+	sinksLock.RLock()
+	defer sinksLock.RUnlock()
+	return len(sinks) > 0
+}
+
+// Buffer returns the ring buffer of recent events, or nil if it is disabled.
+func Buffer() *RingBuffer {
+	sinksLock.RLock()
+	defer sinksLock.RUnlock()
+	return buffer
+}
+
+// Record sends the event to all sinks.
+func Record(event *Event) {
+	sinksLock.RLock()
+	defer sinksLock.RUnlock()
+	for _, sink := range sinks {
+		sink.Record(event)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"github.com/karmada-io/dashboard/pkg/common/types"
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+// Properties of audit events which can be used to filter and sort, in addition to name, namespace
+// and creationTimestamp (the time of the event).
+const (
+	UserProperty     dataselect.PropertyName = "user"
+	VerbProperty     dataselect.PropertyName = "verb"
+	ClusterProperty  dataselect.PropertyName = "cluster"
+	ResourceProperty dataselect.PropertyName = "resource"
+	ResultProperty   dataselect.PropertyName = "result"
+)
+
+// EventList contains the buffered audit events.
+type EventList struct {
+	ListMeta types.ListMeta `json:"listMeta"`
+	Events   []Event        `json:"events"`
+}
+
+// GetEventList returns the events of the ring buffer, newest first unless dsQuery sorts otherwise.
+func GetEventList(ringBuffer *RingBuffer, dsQuery *dataselect.DataSelectQuery) *EventList {
+	events := ringBuffer.List()
+	cells := make([]dataselect.DataCell, len(events))
+	for i := range events {
+		cells[len(events)-1-i] = EventCell(events[i])
+	}
+	selected, filteredTotal := dataselect.GenericDataSelectWithFilter(cells, dsQuery)
+	result := &EventList{
+		ListMeta: types.ListMeta{TotalItems: filteredTotal},
+		Events:   make([]Event, 0, len(selected)),
+	}
+	for _, cell := range selected {
+		result.Events = append(result.Events, Event(cell.(EventCell)))
+	}
+	return result
+}
+
+// EventCell is a cell representation of an audit Event.
+type EventCell Event
+
+// GetProperty returns specific property of EventCell.
+func (c EventCell) GetProperty(name dataselect.PropertyName) dataselect.ComparableValue {
+	switch name {
+	case dataselect.NameProperty:
+		return dataselect.StdComparableString(c.Name)
+	case dataselect.NamespaceProperty:
+		return dataselect.StdComparableString(c.Namespace)
+	case dataselect.CreationTimestampProperty:
+		return dataselect.StdComparableTime(c.Timestamp)
+	case UserProperty:
+		return dataselect.StdComparableString(c.User)
+	case VerbProperty:
+		return dataselect.StdComparableString(c.Verb)
+	case ClusterProperty:
+		return dataselect.StdComparableString(c.Cluster)
+	case ResourceProperty:
+		return dataselect.StdComparableString(c.Resource)
+	case ResultProperty:
+		return dataselect.StdComparableString(c.Result)
+	default:
+		// if name is not supported then just return a constant dummy value, sort will have no effect.
+		return nil
+	}
+}
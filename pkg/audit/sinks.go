@@ -0,0 +1,151 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// fileSink appends events as JSON lines to a file.
+type fileSink struct {
+	mu      sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+}
+
+// NewFileSink returns a Sink which appends events as JSON lines to the file at path.
+func NewFileSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSink{file: file, encoder: json.NewEncoder(file)}, nil
+}
+
+func (f *fileSink) Record(event *Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.encoder.Encode(event); err != nil {
+		klog.ErrorS(err, "Could not write audit event", "file", f.file.Name())
+	}
+}
+
+// RingBuffer keeps the most recent events in memory.
+type RingBuffer struct {
+	mu     sync.RWMutex
+	events []Event
+	next   int
+	full   bool
+}
+
+// NewRingBuffer returns a RingBuffer which holds up to size events.
+func NewRingBuffer(size int) *RingBuffer {
+	return &RingBuffer{events: make([]Event, size)}
+}
+
+// Record implements Sink.
+func (r *RingBuffer) Record(event *Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.events) == 0 {
+		return
+	}
+	r.events[r.next] = *event
+	r.next = (r.next + 1) % len(r.events)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// List returns the buffered events, oldest first.
+func (r *RingBuffer) List() []Event {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if !r.full {
+		return append([]Event(nil), r.events[:r.next]...)
+	}
+	result := make([]Event, 0, len(r.events))
+	result = append(result, r.events[r.next:]...)
+	return append(result, r.events[:r.next]...)
+}
+
+const (
+	webhookQueueSize = 1000
+	webhookTimeout   = 5 * time.Second
+)
+
+// webhookSink posts every event as JSON to a URL. Events are sent in the background and dropped
+// if the webhook can not keep up.
+type webhookSink struct {
+	url    string
+	client *http.Client
+	queue  chan *Event
+}
+
+// NewWebhookSink returns a Sink which posts events to url until stopCh is closed.
+func NewWebhookSink(url string, stopCh <-chan struct{}) Sink {
+	w := &webhookSink{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+		queue:  make(chan *Event, webhookQueueSize),
+	}
+	go w.run(stopCh)
+	return w
+}
+
+func (w *webhookSink) Record(event *Event) {
+	select {
+	case w.queue <- event:
+	default:
+		klog.Warningf("Audit webhook queue is full, dropping event of %s %s", event.Verb, event.Path)
+	}
+}
+
+func (w *webhookSink) run(stopCh <-chan struct{}) {
+	for {
+		select {
+		case <-stopCh:
+			return
+		case event := <-w.queue:
+			w.send(event)
+		}
+	}
+}
+
+func (w *webhookSink) send(event *Event) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		klog.ErrorS(err, "Could not encode audit event")
+		return
+	}
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		klog.ErrorS(err, "Could not send audit event", "url", w.url)
+		return
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		klog.Warningf("Audit webhook %s responded with status %d", w.url, resp.StatusCode)
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+)
+
+func TestRingBuffer(t *testing.T) {
+	ringBuffer := NewRingBuffer(3)
+	for _, name := range []string{"a", "b", "c", "d"} {
+		ringBuffer.Record(&Event{Name: name, User: "alice", Result: ResultSuccess})
+	}
+	events := ringBuffer.List()
+	if len(events) != 3 || events[0].Name != "b" || events[2].Name != "d" {
+		t.Fatalf("List() = %+v, expected the three newest events oldest first", events)
+	}
+
+	ringBuffer.Record(&Event{Name: "e", User: "bob", Result: ResultFailure})
+	query := dataselect.NewDataSelectQuery(dataselect.NoPagination, dataselect.NoSort,
+		dataselect.NewFilterQuery([]string{string(UserProperty), "bob"}))
+	list := GetEventList(ringBuffer, query)
+	if list.ListMeta.TotalItems != 1 || list.Events[0].Name != "e" {
+		t.Errorf("GetEventList() = %+v, expected only the event of bob", list)
+	}
+
+	list = GetEventList(ringBuffer, dataselect.NoDataSelect)
+	if list.Events[0].Name != "e" {
+		t.Errorf("GetEventList() = %+v, expected the newest event first", list)
+	}
+}
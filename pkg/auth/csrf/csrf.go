@@ -0,0 +1,137 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csrf
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+
+	"golang.org/x/net/xsrftoken"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/auth/session"
+)
+
+const (
+	// HeaderName is the request header carrying the csrf token.
+	HeaderName = "X-CSRF-TOKEN"
+	// SecretName is the name of the secret holding the signing key, shared by all replicas.
+	SecretName = "karmada-dashboard-csrf"
+	secretKey  = "csrf"
+	// actionID scopes the tokens to the dashboard api.
+	actionID = "karmada-dashboard-api"
+)
+
+var (
+	enabled bool
+	key     string
+)
+
+// Init enables csrf protection. The signing key is read from the csrf secret in the given namespace of
+// the host cluster and generated if the secret does not exist yet. If the secret can not be accessed, a
+// random key is used, so tokens are only valid on this replica until it restarts.
+func Init(client kubernetes.Interface, namespace string) {
+	k, err := ensureKey(context.TODO(), client, namespace)
+	if err != nil {
+		klog.ErrorS(err, "Could not load csrf key from secret, using a random key", "namespace", namespace, "secret", SecretName)
+		k, err = newKey()
+		if err != nil {
+			klog.Fatalf("Could not generate csrf key: %v", err)
+		}
+	}
+	key = k
+	enabled = true
+}
+
+// Enabled returns true if mutating requests need a csrf token.
+func Enabled() bool {
+	return enabled
+}
+
+// Generate returns a csrf token bound to the session of the request, if any.
+func Generate(request *http.Request) string {
+	return xsrftoken.Generate(key, userID(request), actionID)
+}
+
+// Valid checks the csrf token of the request header.
+func Valid(request *http.Request) bool {
+	token := request.Header.Get(HeaderName)
+	if token == "" {
+		return false
+	}
+	return xsrftoken.Valid(token, key, userID(request), actionID)
+}
+
+// userID binds tokens to the session cookie, so a token leaked from one session is useless in another.
+// Token based logins without a session share the empty user id.
+func userID(request *http.Request) string {
+	cookie, err := request.Cookie(session.CookieName)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
+func ensureKey(ctx context.Context, client kubernetes.Interface, namespace string) (string, error) {
+	secrets := client.CoreV1().Secrets(namespace)
+	secret, err := secrets.Get(ctx, SecretName, metav1.GetOptions{})
+	found := err == nil
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", err
+	}
+	if found && len(secret.Data[secretKey]) > 0 {
+		return string(secret.Data[secretKey]), nil
+	}
+
+	k, err := newKey()
+	if err != nil {
+		return "", err
+	}
+	if found {
+		// the secret exists without a key
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+		secret.Data[secretKey] = []byte(k)
+		_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+		return k, err
+	}
+	_, err = secrets.Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: SecretName, Namespace: namespace},
+		Type:       corev1.SecretTypeOpaque,
+		Data:       map[string][]byte{secretKey: []byte(k)},
+	}, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		// another replica won the race, use its key
+		return ensureKey(ctx, client, namespace)
+	}
+	return k, err
+}
+
+func newKey() (string, error) {
+	buff := make([]byte, 32)
+	if _, err := rand.Read(buff); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buff), nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csrf
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/dashboard/pkg/auth/session"
+)
+
+func request(token, sessionID string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/config", nil)
+	if token != "" {
+		req.Header.Set(HeaderName, token)
+	}
+	if sessionID != "" {
+		req.AddCookie(&http.Cookie{Name: session.CookieName, Value: sessionID})
+	}
+	return req
+}
+
+func TestInit(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	Init(client, "karmada-system")
+	t.Cleanup(func() { enabled, key = false, "" })
+
+	if !Enabled() {
+		t.Fatal("expected csrf protection to be enabled")
+	}
+	secret, err := client.CoreV1().Secrets("karmada-system").Get(context.TODO(), SecretName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(secret.Data[secretKey]) != key {
+		t.Errorf("expected the signing key to be stored in the secret")
+	}
+
+	// a second replica reuses the stored key
+	stored := key
+	Init(client, "karmada-system")
+	if key != stored {
+		t.Errorf("expected the key of the existing secret to be reused")
+	}
+}
+
+func TestValid(t *testing.T) {
+	key = "test-key"
+	t.Cleanup(func() { key = "" })
+
+	token := Generate(request("", "session-a"))
+	tests := []struct {
+		name  string
+		req   *http.Request
+		valid bool
+	}{
+		{name: "token of the session", req: request(token, "session-a"), valid: true},
+		{name: "missing token", req: request("", "session-a"), valid: false},
+		{name: "malformed token", req: request("not-a-token", "session-a"), valid: false},
+		{name: "token of another session", req: request(token, "session-b"), valid: false},
+		{name: "token without a session", req: request(token, ""), valid: false},
+		{name: "token logins share the empty session", req: request(Generate(request("", "")), ""), valid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Valid(tt.req); got != tt.valid {
+				t.Errorf("Valid() = %v, want %v", got, tt.valid)
+			}
+		})
+	}
+
+	key = "rotated-key"
+	if Valid(request(token, "session-a")) {
+		t.Errorf("expected tokens signed with another key to be rejected")
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+)
+
+// StateCookieName is the name of the cookie which binds a started login to the browser that started it.
+// Without it, a callback url carrying the code and state of someone else's login would sign the
+// browser in as that user.
+const StateCookieName = "karmada-dashboard-oidc-state"
+
+// SetStateCookie binds the login with the given state to the browser of the request. The cookie only
+// holds a hash of the state and expires with the pending login.
+func SetStateCookie(w http.ResponseWriter, request *http.Request, state string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     StateCookieName,
+		Value:    hashState(state),
+		Path:     "/",
+		MaxAge:   int(pendingAuthTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   isSecure(request),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// VerifyStateCookie returns ErrInvalidState unless the request carries the cookie set for the state.
+func VerifyStateCookie(request *http.Request, state string) error {
+	cookie, err := request.Cookie(StateCookieName)
+	if err != nil || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(hashState(state))) != 1 {
+		return ErrInvalidState
+	}
+	return nil
+}
+
+// ClearStateCookie removes the state cookie from the browser once the login finished.
+func ClearStateCookie(w http.ResponseWriter, request *http.Request) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     StateCookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   isSecure(request),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func hashState(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return hex.EncodeToString(sum[:])
+}
+
+func isSecure(request *http.Request) bool {
+	return request.TLS != nil || request.Header.Get("X-Forwarded-Proto") == "https"
+}
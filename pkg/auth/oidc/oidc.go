@@ -0,0 +1,335 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// pendingAuthTTL is how long a started login may take before its state is dropped.
+	pendingAuthTTL = 10 * time.Minute
+	// wellKnownPath is the path of the OpenID provider configuration document.
+	wellKnownPath = "/.well-known/openid-configuration"
+)
+
+var (
+	// ErrNotConfigured is returned when the OIDC login flow is used without an issuer being configured.
+	ErrNotConfigured = errors.New("oidc login is not configured")
+	// ErrInvalidState is returned when the callback state is unknown or has expired.
+	ErrInvalidState = errors.New("invalid or expired oidc login state")
+
+	defaultProvider *Provider
+)
+
+// Config contains the settings of the OpenID Connect provider used for dashboard login.
+type Config struct {
+	// IssuerURL is the URL of the provider, it must serve the discovery document.
+	IssuerURL string
+	// ClientID is the OAuth2 client id of the dashboard, it is also the expected audience of id tokens.
+	ClientID string
+	// ClientSecret is the OAuth2 client secret, empty for public clients.
+	ClientSecret string
+	// RedirectURL is the callback url registered at the provider.
+	RedirectURL string
+	// Scopes requested in addition to openid.
+	Scopes []string
+	// CAFile is an optional path to the CA bundle used to verify the provider certificate.
+	CAFile string
+}
+
+// Token is the result of a successful login or refresh.
+type Token struct {
+	// IDToken is forwarded to the Karmada API server as bearer token.
+	IDToken      string
+	RefreshToken string
+	Expiry       time.Time
+	Claims       map[string]interface{}
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type pendingAuth struct {
+	nonce     string
+	verifier  string
+	expiresAt time.Time
+}
+
+// Provider drives the authorization code flow with PKCE against an OpenID Connect provider.
+type Provider struct {
+	config     Config
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu        sync.Mutex
+	discovery *discoveryDocument
+	keys      *keySet
+	pending   map[string]pendingAuth
+}
+
+// NewProvider creates a Provider for the given config. The discovery document is fetched on first use.
+func NewProvider(config Config) (*Provider, error) {
+	if config.IssuerURL == "" || config.ClientID == "" || config.RedirectURL == "" {
+		return nil, fmt.Errorf("oidc issuer url, client id and redirect url must be set")
+	}
+	httpClient, err := newHTTPClient(config.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		config:     config,
+		httpClient: httpClient,
+		now:        time.Now,
+		pending:    make(map[string]pendingAuth),
+	}, nil
+}
+
+// InitProvider configures the provider used by the dashboard login routes.
+// An empty issuer url leaves OIDC login disabled.
+func InitProvider(config Config) error {
+	if config.IssuerURL == "" {
+		return nil
+	}
+	provider, err := NewProvider(config)
+	if err != nil {
+		return err
+	}
+	defaultProvider = provider
+	klog.InfoS("OIDC login enabled", "issuer", config.IssuerURL, "clientID", config.ClientID)
+	return nil
+}
+
+// GetProvider returns the provider configured by InitProvider, or ErrNotConfigured.
+func GetProvider() (*Provider, error) {
+	if defaultProvider == nil {
+		return nil, ErrNotConfigured
+	}
+	return defaultProvider, nil
+}
+
+// AuthCodeURL starts a login and returns the url of the provider the user has to be redirected to,
+// and the state of the login which has to be bound to the browser with SetStateCookie.
+func (p *Provider) AuthCodeURL(ctx context.Context) (string, string, error) {
+	oauth2Config, err := p.oauth2Config(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	state, err := randomString()
+	if err != nil {
+		return "", "", err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return "", "", err
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	p.mu.Lock()
+	p.sweepPendingLocked()
+	p.pending[state] = pendingAuth{nonce: nonce, verifier: verifier, expiresAt: p.now().Add(pendingAuthTTL)}
+	p.mu.Unlock()
+
+	return oauth2Config.AuthCodeURL(state,
+		oauth2.S256ChallengeOption(verifier),
+		oauth2.SetAuthURLParam("nonce", nonce),
+	), state, nil
+}
+
+// Exchange finishes a login started by AuthCodeURL and returns the verified tokens.
+func (p *Provider) Exchange(ctx context.Context, code, state string) (*Token, error) {
+	p.mu.Lock()
+	pending, ok := p.pending[state]
+	delete(p.pending, state)
+	p.mu.Unlock()
+	if !ok || p.now().After(pending.expiresAt) {
+		return nil, ErrInvalidState
+	}
+
+	oauth2Config, err := p.oauth2Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := oauth2Config.Exchange(p.clientContext(ctx), code, oauth2.VerifierOption(pending.verifier))
+	if err != nil {
+		return nil, err
+	}
+	return p.toToken(ctx, token, pending.nonce)
+}
+
+// Refresh exchanges a refresh token for a new id token.
+func (p *Provider) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	oauth2Config, err := p.oauth2Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+	source := oauth2Config.TokenSource(p.clientContext(ctx), &oauth2.Token{RefreshToken: refreshToken})
+	token, err := source.Token()
+	if err != nil {
+		return nil, err
+	}
+	result, err := p.toToken(ctx, token, "")
+	if err != nil {
+		return nil, err
+	}
+	// Providers may not rotate the refresh token, keep using the old one in that case.
+	if result.RefreshToken == "" {
+		result.RefreshToken = refreshToken
+	}
+	return result, nil
+}
+
+func (p *Provider) toToken(ctx context.Context, token *oauth2.Token, nonce string) (*Token, error) {
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, fmt.Errorf("no id_token in token response")
+	}
+	claims, expiry, err := p.verifyIDToken(ctx, rawIDToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &Token{
+		IDToken:      rawIDToken,
+		RefreshToken: token.RefreshToken,
+		Expiry:       expiry,
+		Claims:       claims,
+	}, nil
+}
+
+func (p *Provider) oauth2Config(ctx context.Context) (*oauth2.Config, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+	scopes := []string{"openid"}
+	for _, scope := range p.config.Scopes {
+		if scope != "" && scope != "openid" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return &oauth2.Config{
+		ClientID:     p.config.ClientID,
+		ClientSecret: p.config.ClientSecret,
+		RedirectURL:  p.config.RedirectURL,
+		Scopes:       scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  discovery.AuthorizationEndpoint,
+			TokenURL: discovery.TokenEndpoint,
+		},
+	}, nil
+}
+
+func (p *Provider) getDiscovery(ctx context.Context) (*discoveryDocument, error) {
+	p.mu.Lock()
+	cached := p.discovery
+	p.mu.Unlock()
+	if cached != nil {
+		return cached, nil
+	}
+
+	// fetched without the lock, a slow provider must not block the logins waiting for p.mu
+	wellKnown := strings.TrimSuffix(p.config.IssuerURL, "/") + wellKnownPath
+	discovery := &discoveryDocument{}
+	if err := p.getJSON(ctx, wellKnown, discovery); err != nil {
+		return nil, fmt.Errorf("failed to fetch oidc discovery document: %w", err)
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != strings.TrimSuffix(p.config.IssuerURL, "/") {
+		return nil, fmt.Errorf("oidc issuer mismatch, expected %q got %q", p.config.IssuerURL, discovery.Issuer)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// a concurrent fetch may have won, keep its key set
+	if p.discovery == nil {
+		p.discovery = discovery
+		p.keys = newKeySet(discovery.JWKSURI, p.getJSON)
+	}
+	return p.discovery, nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// clientContext makes the oauth2 package use the http client that trusts the configured CA.
+func (p *Provider) clientContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+}
+
+func (p *Provider) sweepPendingLocked() {
+	now := p.now()
+	for state, pending := range p.pending {
+		if now.After(pending.expiresAt) {
+			delete(p.pending, state)
+		}
+	}
+}
+
+func newHTTPClient(caFile string) (*http.Client, error) {
+	if caFile == "" {
+		return &http.Client{Timeout: 30 * time.Second}, nil
+	}
+	caData, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caData) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	return &http.Client{Timeout: 30 * time.Second, Transport: transport}, nil
+}
+
+func randomString() (string, error) {
+	buff := make([]byte, 32)
+	if _, err := rand.Read(buff); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buff), nil
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// mockIdP is a minimal OpenID provider which issues RS256 signed id tokens.
+type mockIdP struct {
+	server    *httptest.Server
+	key       *rsa.PrivateKey
+	challenge string
+	nonce     string
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp := &mockIdP{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc(wellKnownPath, func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(discoveryDocument{
+			Issuer:                idp.server.URL,
+			AuthorizationEndpoint: idp.server.URL + "/authorize",
+			TokenEndpoint:         idp.server.URL + "/token",
+			JWKSURI:               idp.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(jsonWebKeySet{Keys: []jsonWebKey{{
+			Kty: "RSA",
+			Kid: "test",
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		switch r.Form.Get("grant_type") {
+		case "authorization_code":
+			sum := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+			if r.Form.Get("code") != "code" || base64.RawURLEncoding.EncodeToString(sum[:]) != idp.challenge {
+				http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+				return
+			}
+		case "refresh_token":
+			if r.Form.Get("refresh_token") != "refresh" {
+				http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+				return
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token":  "access",
+			"token_type":    "Bearer",
+			"refresh_token": "refresh",
+			"expires_in":    3600,
+			"id_token":      idp.idToken(t, idp.nonce),
+		})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+func (m *mockIdP) idToken(t *testing.T, nonce string) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   m.server.URL,
+		"aud":   "dashboard",
+		"sub":   "alice",
+		"email": "alice@example.com",
+		"nonce": nonce,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	})
+	token.Header["kid"] = "test"
+	signed, err := token.SignedString(m.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestProviderLoginFlow(t *testing.T) {
+	idp := newMockIdP(t)
+	provider, err := NewProvider(Config{
+		IssuerURL:   idp.server.URL,
+		ClientID:    "dashboard",
+		RedirectURL: "http://localhost/login/oidc/callback",
+		Scopes:      []string{"email"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authURL, state, err := provider.AuthCodeURL(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := parsed.Query()
+	if query.Get("state") != state {
+		t.Fatalf("expected the returned state %q in the auth url, got %q", state, query.Get("state"))
+	}
+	if query.Get("code_challenge_method") != "S256" {
+		t.Fatalf("expected S256 code challenge, got %q", query.Get("code_challenge_method"))
+	}
+	idp.challenge = query.Get("code_challenge")
+	idp.nonce = query.Get("nonce")
+
+	if _, err = provider.Exchange(context.TODO(), "code", "unknown"); err != ErrInvalidState {
+		t.Fatalf("Exchange() with unknown state returned %v, expected %v", err, ErrInvalidState)
+	}
+
+	token, err := provider.Exchange(context.TODO(), "code", query.Get("state"))
+	if err != nil {
+		t.Fatalf("Exchange() returned %v", err)
+	}
+	if token.Claims["email"] != "alice@example.com" || token.RefreshToken != "refresh" {
+		t.Errorf("unexpected token %+v", token)
+	}
+
+	// the state can only be used once
+	if _, err = provider.Exchange(context.TODO(), "code", query.Get("state")); err != ErrInvalidState {
+		t.Errorf("second Exchange() returned %v, expected %v", err, ErrInvalidState)
+	}
+
+	refreshed, err := provider.Refresh(context.TODO(), "refresh")
+	if err != nil {
+		t.Fatalf("Refresh() returned %v", err)
+	}
+	if refreshed.IDToken == "" {
+		t.Errorf("expected refreshed id token")
+	}
+}
+
+func TestProviderRejectsNonceMismatch(t *testing.T) {
+	idp := newMockIdP(t)
+	provider, err := NewProvider(Config{
+		IssuerURL:   idp.server.URL,
+		ClientID:    "dashboard",
+		RedirectURL: "http://localhost/login/oidc/callback",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	authURL, _, err := provider.AuthCodeURL(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, _ := url.Parse(authURL)
+	idp.challenge = parsed.Query().Get("code_challenge")
+	idp.nonce = "another-nonce"
+
+	if _, err = provider.Exchange(context.TODO(), "code", parsed.Query().Get("state")); err == nil {
+		t.Errorf("expected Exchange() to reject an id token with a different nonce")
+	}
+}
+
+func TestStateCookie(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	SetStateCookie(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/login/oidc", nil), "state")
+	cookie := recorder.Result().Cookies()[0]
+	if !cookie.HttpOnly || cookie.Value == "state" {
+		t.Fatalf("expected an HttpOnly cookie with a hash of the state, got %+v", cookie)
+	}
+
+	callback := func(cookies ...*http.Cookie) *http.Request {
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/login/oidc/callback", nil)
+		for _, c := range cookies {
+			request.AddCookie(c)
+		}
+		return request
+	}
+	if err := VerifyStateCookie(callback(cookie), "state"); err != nil {
+		t.Errorf("VerifyStateCookie() returned %v for the browser which started the login", err)
+	}
+	// the code and state of a login started in another browser
+	if err := VerifyStateCookie(callback(cookie), "another-state"); err != ErrInvalidState {
+		t.Errorf("VerifyStateCookie() with another state returned %v, expected %v", err, ErrInvalidState)
+	}
+	if err := VerifyStateCookie(callback(), "state"); err != ErrInvalidState {
+		t.Errorf("VerifyStateCookie() without cookie returned %v, expected %v", err, ErrInvalidState)
+	}
+}
+
+func TestDiscoveryDoesNotBlockLogins(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+	provider, err := NewProvider(Config{IssuerURL: server.URL, ClientID: "dashboard", RedirectURL: "http://localhost/callback"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() { _, _, _ = provider.AuthCodeURL(context.TODO()) }()
+	time.Sleep(10 * time.Millisecond)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := provider.Exchange(context.TODO(), "code", "unknown")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != ErrInvalidState {
+			t.Errorf("Exchange() returned %v, expected %v", err, ErrInvalidState)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Exchange() was blocked by the pending discovery fetch")
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// jsonWebKey is the subset of RFC 7517 fields needed to verify id tokens.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type getJSONFunc func(ctx context.Context, url string, out interface{}) error
+
+// keySet caches the signing keys of the provider and refetches them when an unknown key id shows up.
+type keySet struct {
+	url     string
+	getJSON getJSONFunc
+
+	mu   sync.Mutex
+	keys map[string]crypto.PublicKey
+}
+
+func newKeySet(url string, getJSON getJSONFunc) *keySet {
+	return &keySet{url: url, getJSON: getJSON}
+}
+
+func (k *keySet) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if key, ok := k.lookupLocked(kid); ok {
+		return key, nil
+	}
+	if err := k.refreshLocked(ctx); err != nil {
+		return nil, err
+	}
+	if key, ok := k.lookupLocked(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("no signing key with id %q", kid)
+}
+
+func (k *keySet) lookupLocked(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(k.keys) == 1 {
+		for _, key := range k.keys {
+			return key, true
+		}
+	}
+	key, ok := k.keys[kid]
+	return key, ok
+}
+
+func (k *keySet) refreshLocked(ctx context.Context) error {
+	set := &jsonWebKeySet{}
+	if err := k.getJSON(ctx, k.url, set); err != nil {
+		return fmt.Errorf("failed to fetch oidc signing keys: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return err
+		}
+		keys[jwk.Kid] = key
+	}
+	k.keys = keys
+	return nil
+}
+
+func (j jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch j.Kty {
+	case "RSA":
+		n, err := decodeBigInt(j.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(j.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch j.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported elliptic curve %q", j.Crv)
+		}
+		x, err := decodeBigInt(j.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(j.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", j.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	buff, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(buff), nil
+}
+
+// verifyIDToken checks the signature, issuer, audience, expiry and nonce of an id token and returns its claims.
+// An empty nonce skips the nonce check, which is the case for refreshed tokens.
+func (p *Provider) verifyIDToken(ctx context.Context, rawIDToken, nonce string) (map[string]interface{}, time.Time, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	p.mu.Lock()
+	keys := p.keys
+	p.mu.Unlock()
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return keys.key(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "PS256", "PS384", "PS512"}),
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(p.now),
+	)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("invalid id token: %w", err)
+	}
+	if nonce != "" {
+		if tokenNonce, _ := claims["nonce"].(string); tokenNonce != nonce {
+			return nil, time.Time{}, fmt.Errorf("invalid id token: nonce mismatch")
+		}
+	}
+	expiry, err := claims.GetExpirationTime()
+	if err != nil || expiry == nil {
+		return nil, time.Time{}, fmt.Errorf("invalid id token: missing expiry")
+	}
+	return claims, expiry.Time, nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"github.com/karmada-io/dashboard/pkg/config"
+)
+
+const (
+	// VerbRead allows GET requests.
+	VerbRead = "read"
+	// VerbWrite allows POST, PUT, PATCH and DELETE requests.
+	VerbWrite = "write"
+	// All matches every resource, verb or menu.
+	All = "*"
+
+	// RoleViewer can read everything.
+	RoleViewer = "viewer"
+	// RoleOperator can read everything and change resources, but not the dashboard itself.
+	RoleOperator = "operator"
+	// RoleAdmin has full access.
+	RoleAdmin = "admin"
+)
+
+// operatorResources are the resources an operator may write, everything but the dashboard config.
+var operatorResources = []string{
+	"_raw", "cluster", "clusteroverridepolicy", "clusterpropagationpolicy", "configmap", "cronjob",
+	"daemonset", "deployment", "ingress", "job", "namespace", "nodes", "overridepolicy", "pods",
+	"propagationpolicy", "secret", "service", "statefulset",
+}
+
+var builtinRoles = map[string]config.Role{
+	RoleViewer: {
+		Name:  RoleViewer,
+		Rules: []config.RoleRule{{Verbs: []string{VerbRead}, Resources: []string{All}}},
+		Menus: []string{"OVERVIEW", "MULTICLOUD-RESOURCE-MANAGE", "NAMESPACE", "WORKLOAD", "SERVICE", "CONFIG",
+			"MULTICLOUD-POLICY-MANAGE", "PROPAGATION-POLICY", "OVERRIDE-POLICY", "CLUSTER-MANAGE"},
+	},
+	RoleOperator: {
+		Name: RoleOperator,
+		Rules: []config.RoleRule{
+			{Verbs: []string{VerbRead}, Resources: []string{All}},
+			{Verbs: []string{VerbWrite}, Resources: operatorResources},
+		},
+		Menus: []string{"OVERVIEW", "MULTICLOUD-RESOURCE-MANAGE", "NAMESPACE", "WORKLOAD", "SERVICE", "CONFIG",
+			"MULTICLOUD-POLICY-MANAGE", "PROPAGATION-POLICY", "OVERRIDE-POLICY", "CLUSTER-MANAGE"},
+	},
+	RoleAdmin: {
+		Name:  RoleAdmin,
+		Rules: []config.RoleRule{{Verbs: []string{All}, Resources: []string{All}}},
+		Menus: []string{All},
+	},
+}
+
+// Authorizer decides on the dashboard roles of a DashboardConfig.
+type Authorizer struct {
+	roles       map[string]config.Role
+	bindings    []config.RoleBinding
+	defaultRole string
+}
+
+// NewAuthorizer returns the authorizer for the roles of the given config, or nil if the config has
+// no role bindings, in which case dashboard RBAC is disabled.
+func NewAuthorizer(dashboardConfig config.DashboardConfig) *Authorizer {
+	if len(dashboardConfig.RoleBindings) == 0 {
+		return nil
+	}
+	roles := make(map[string]config.Role, len(builtinRoles)+len(dashboardConfig.Roles))
+	for name, role := range builtinRoles {
+		roles[name] = role
+	}
+	for _, role := range dashboardConfig.Roles {
+		roles[role.Name] = role
+	}
+	return &Authorizer{
+		roles:       roles,
+		bindings:    dashboardConfig.RoleBindings,
+		defaultRole: dashboardConfig.DefaultRole,
+	}
+}
+
+// RolesFor returns the names of the roles bound to the user or one of its groups.
+func (a *Authorizer) RolesFor(user string, groups []string) []string {
+	var result []string
+	seen := make(map[string]bool)
+	for _, binding := range a.bindings {
+		if seen[binding.Role] || !(contains(binding.Users, user) || containsAny(binding.Groups, groups)) {
+			continue
+		}
+		seen[binding.Role] = true
+		result = append(result, binding.Role)
+	}
+	if len(result) == 0 && a.defaultRole != "" {
+		result = append(result, a.defaultRole)
+	}
+	return result
+}
+
+// Allowed returns true if one of the roles grants verb on resource.
+func (a *Authorizer) Allowed(roles []string, verb, resource string) bool {
+	for _, name := range roles {
+		role, ok := a.roles[name]
+		if !ok {
+			continue
+		}
+		for _, rule := range role.Rules {
+			if matches(rule.Verbs, verb) && matches(rule.Resources, resource) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FilterMenus returns the menu entries visible to one of the roles. Children are filtered as well,
+// an entry stays visible if its own key is granted.
+func (a *Authorizer) FilterMenus(roles []string, menus []config.MenuConfig) []config.MenuConfig {
+	visible := make(map[string]bool)
+	for _, name := range roles {
+		for _, key := range a.roles[name].Menus {
+			visible[key] = true
+		}
+	}
+	return filterMenus(visible, menus)
+}
+
+func filterMenus(visible map[string]bool, menus []config.MenuConfig) []config.MenuConfig {
+	result := make([]config.MenuConfig, 0, len(menus))
+	for _, menu := range menus {
+		if !visible[All] && !visible[menu.SidebarKey] {
+			continue
+		}
+		if len(menu.Children) > 0 {
+			menu.Children = filterMenus(visible, menu.Children)
+		}
+		result = append(result, menu)
+	}
+	return result
+}
+
+func matches(values []string, value string) bool {
+	return contains(values, All) || contains(values, value)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(values, candidates []string) bool {
+	for _, candidate := range candidates {
+		if contains(values, candidate) {
+			return true
+		}
+	}
+	return false
+}
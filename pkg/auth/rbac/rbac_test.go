@@ -0,0 +1,94 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/karmada-io/dashboard/pkg/config"
+)
+
+func TestAuthorizer(t *testing.T) {
+	if NewAuthorizer(config.DashboardConfig{}) != nil {
+		t.Fatalf("expected dashboard RBAC to be disabled without role bindings")
+	}
+
+	authorizer := NewAuthorizer(config.DashboardConfig{
+		Roles: []config.Role{{
+			Name:  "policy-editor",
+			Rules: []config.RoleRule{{Verbs: []string{VerbRead, VerbWrite}, Resources: []string{"propagationpolicy"}}},
+			Menus: []string{"MULTICLOUD-POLICY-MANAGE", "PROPAGATION-POLICY"},
+		}},
+		RoleBindings: []config.RoleBinding{
+			{Role: RoleAdmin, Users: []string{"alice"}},
+			{Role: RoleViewer, Groups: []string{"developers"}},
+			{Role: "policy-editor", Groups: []string{"developers"}},
+		},
+	})
+
+	tests := []struct {
+		user     string
+		groups   []string
+		verb     string
+		resource string
+		allowed  bool
+	}{
+		{"alice", nil, VerbWrite, "config", true},
+		{"bob", []string{"developers"}, VerbRead, "cluster", true},
+		{"bob", []string{"developers"}, VerbWrite, "cluster", false},
+		{"bob", []string{"developers"}, VerbWrite, "propagationpolicy", true},
+		{"carol", []string{"testers"}, VerbRead, "cluster", false},
+	}
+	for _, tt := range tests {
+		roles := authorizer.RolesFor(tt.user, tt.groups)
+		if got := authorizer.Allowed(roles, tt.verb, tt.resource); got != tt.allowed {
+			t.Errorf("Allowed(%v, %s, %s) for %s = %v, expected %v", roles, tt.verb, tt.resource, tt.user, got, tt.allowed)
+		}
+	}
+
+	menus := []config.MenuConfig{
+		{Path: "/overview", SidebarKey: "OVERVIEW"},
+		{Path: "/multicloud-policy-manage", SidebarKey: "MULTICLOUD-POLICY-MANAGE", Children: []config.MenuConfig{
+			{Path: "propagation-policy", SidebarKey: "PROPAGATION-POLICY"},
+			{Path: "override-policy", SidebarKey: "OVERRIDE-POLICY"},
+		}},
+	}
+	filtered := authorizer.FilterMenus([]string{"policy-editor"}, menus)
+	expected := []config.MenuConfig{
+		{Path: "/multicloud-policy-manage", SidebarKey: "MULTICLOUD-POLICY-MANAGE", Children: []config.MenuConfig{
+			{Path: "propagation-policy", SidebarKey: "PROPAGATION-POLICY"},
+		}},
+	}
+	if !reflect.DeepEqual(filtered, expected) {
+		t.Errorf("FilterMenus() = %+v, expected %+v", filtered, expected)
+	}
+	if got := authorizer.FilterMenus([]string{RoleAdmin}, menus); !reflect.DeepEqual(got, menus) {
+		t.Errorf("FilterMenus() for admin = %+v, expected all menus", got)
+	}
+}
+
+func TestBuiltinRoleNames(t *testing.T) {
+	if len(config.BuiltinRoleNames) != len(builtinRoles) {
+		t.Fatalf("config.BuiltinRoleNames %v does not match the built-in roles", config.BuiltinRoleNames)
+	}
+	for _, name := range config.BuiltinRoleNames {
+		if _, ok := builtinRoles[name]; !ok {
+			t.Errorf("%s is not a built-in role", name)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryStore keeps sessions in process memory, sessions are lost on restart.
+type memoryStore struct {
+	mu       sync.RWMutex
+	sessions map[string]Session
+	now      func() time.Time
+}
+
+// NewMemoryStore returns a Store which keeps sessions in memory.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		sessions: make(map[string]Session),
+		now:      time.Now,
+	}
+}
+
+func (m *memoryStore) Get(_ context.Context, id string) (*Session, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	session, ok := m.sessions[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &session, nil
+}
+
+func (m *memoryStore) Save(_ context.Context, session *Session) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	for id, existing := range m.sessions {
+		if existing.Expired(now) {
+			delete(m.sessions, id)
+		}
+	}
+	m.sessions[session.ID] = *session
+	return nil
+}
+
+func (m *memoryStore) Delete(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.sessions, id)
+	return nil
+}
+
+func (m *memoryStore) List(_ context.Context) ([]*Session, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	now := m.now()
+	result := make([]*Session, 0, len(m.sessions))
+	for _, session := range m.sessions {
+		if !session.Expired(now) {
+			session := session
+			result = append(result, &session)
+		}
+	}
+	return result, nil
+}
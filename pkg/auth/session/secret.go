@@ -0,0 +1,187 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	secretNamePrefix = "karmada-dashboard-session-"
+	secretDataKey    = "session"
+	// sessionLabel marks the secrets created by the secret store.
+	sessionLabel = "dashboard.karmada.io/session"
+	// secretCacheTTL is how long a session read from a Secret is served from memory. A session
+	// revoked through another replica stays usable on this replica for at most this long.
+	secretCacheTTL = 15 * time.Second
+)
+
+type cachedSession struct {
+	session  Session
+	cachedAt time.Time
+}
+
+// secretStore keeps every session in its own Secret of the host cluster, so sessions
+// survive restarts and are shared between replicas.
+type secretStore struct {
+	client    kubernetes.Interface
+	namespace string
+	now       func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedSession
+}
+
+// NewSecretStore returns a Store which keeps sessions as Secrets in the given namespace.
+func NewSecretStore(client kubernetes.Interface, namespace string) Store {
+	return &secretStore{
+		client:    client,
+		namespace: namespace,
+		now:       time.Now,
+		cache:     make(map[string]cachedSession),
+	}
+}
+
+func (s *secretStore) cached(id string) (*Session, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.cache[id]
+	if !ok || s.now().Sub(entry.cachedAt) > secretCacheTTL {
+		delete(s.cache, id)
+		return nil, false
+	}
+	session := entry.session
+	return &session, true
+}
+
+func (s *secretStore) setCached(session *Session) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	for id, entry := range s.cache {
+		if now.Sub(entry.cachedAt) > secretCacheTTL {
+			delete(s.cache, id)
+		}
+	}
+	s.cache[session.ID] = cachedSession{session: *session, cachedAt: now}
+}
+
+func (s *secretStore) dropCached(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.cache, id)
+}
+
+// secretName derives the secret name from a hash of the id, so the name does not leak the session id.
+func secretName(id string) string {
+	return secretNamePrefix + (&Session{ID: id}).Handle()
+}
+
+func (s *secretStore) Get(ctx context.Context, id string) (*Session, error) {
+	if session, ok := s.cached(id); ok {
+		return session, nil
+	}
+	secret, err := s.client.CoreV1().Secrets(s.namespace).Get(ctx, secretName(id), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	session, err := fromSecret(secret)
+	if err != nil || session.ID != id {
+		return nil, ErrNotFound
+	}
+	s.setCached(session)
+	return session, nil
+}
+
+func (s *secretStore) Save(ctx context.Context, session *Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName(session.ID),
+			Namespace: s.namespace,
+			Labels:    map[string]string{sessionLabel: "true"},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{secretDataKey: data},
+	}
+	secrets := s.client.CoreV1().Secrets(s.namespace)
+	_, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return err
+	}
+	s.setCached(session)
+	return nil
+}
+
+func (s *secretStore) Delete(ctx context.Context, id string) error {
+	s.dropCached(id)
+	err := s.client.CoreV1().Secrets(s.namespace).Delete(ctx, secretName(id), metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// List returns the sessions which have not expired and deletes the expired ones.
+func (s *secretStore) List(ctx context.Context) ([]*Session, error) {
+	secretList, err := s.client.CoreV1().Secrets(s.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{sessionLabel: "true"}.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	result := make([]*Session, 0, len(secretList.Items))
+	for i := range secretList.Items {
+		session, err := fromSecret(&secretList.Items[i])
+		if err != nil {
+			continue
+		}
+		if session.Expired(now) {
+			_ = s.Delete(ctx, session.ID)
+			continue
+		}
+		result = append(result, session)
+	}
+	return result, nil
+}
+
+func fromSecret(secret *corev1.Secret) (*Session, error) {
+	session := &Session{}
+	if err := json.Unmarshal(secret.Data[secretDataKey], session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/auth/oidc"
+)
+
+const (
+	// CookieName is the name of the cookie carrying the session id.
+	CookieName = "karmada-dashboard-session"
+	// DefaultTTL is the default lifetime of a session.
+	DefaultTTL = 12 * time.Hour
+	// refreshBefore is how long before expiry an OIDC id token gets refreshed.
+	refreshBefore = time.Minute
+)
+
+// ErrNotFound is returned by stores when a session does not exist or has expired.
+var ErrNotFound = errors.New("session not found")
+
+// Session keeps the credentials of a logged-in user on the server side.
+type Session struct {
+	ID string `json:"id"`
+	// User is the display name of the user the session belongs to.
+	User string `json:"user"`
+	// Token is the bearer token forwarded to the Karmada API server.
+	Token string `json:"token"`
+	// RefreshToken is the OIDC refresh token, empty for token logins.
+	RefreshToken string `json:"refreshToken,omitempty"`
+	// TokenExpiresAt is the expiry of Token, zero if unknown.
+	TokenExpiresAt time.Time `json:"tokenExpiresAt,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+}
+
+// Handle returns a public identifier of the session. Unlike the id, which is a bearer credential,
+// it can be shown to users to pick a session to revoke.
+func (s *Session) Handle() string {
+	sum := sha256.Sum256([]byte(s.ID))
+	return hex.EncodeToString(sum[:16])
+}
+
+// Expired returns true if the session is no longer valid at the given time.
+func (s *Session) Expired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
+
+// Store persists sessions.
+type Store interface {
+	// Get returns the session with the given id or ErrNotFound.
+	Get(ctx context.Context, id string) (*Session, error)
+	// Save creates or replaces a session.
+	Save(ctx context.Context, session *Session) error
+	// Delete removes the session with the given id, deleting a missing session is not an error.
+	Delete(ctx context.Context, id string) error
+	// List returns all sessions which have not expired.
+	List(ctx context.Context) ([]*Session, error)
+}
+
+// Manager creates, resolves and revokes sessions.
+type Manager struct {
+	store Store
+	ttl   time.Duration
+	now   func() time.Time
+	// refreshToken exchanges an OIDC refresh token for a new token.
+	refreshToken func(ctx context.Context, refreshToken string) (*oidc.Token, error)
+	// refreshes makes concurrent requests of a session share one refresh, rotating identity
+	// providers reject a refresh token after its first use and would sign the others out.
+	refreshes singleflight.Group
+}
+
+var defaultManager *Manager
+
+// NewManager creates a Manager which keeps sessions in the given store for ttl.
+func NewManager(store Store, ttl time.Duration) *Manager {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Manager{store: store, ttl: ttl, now: time.Now, refreshToken: refreshWithProvider}
+}
+
+func refreshWithProvider(ctx context.Context, refreshToken string) (*oidc.Token, error) {
+	provider, err := oidc.GetProvider()
+	if err != nil {
+		return nil, err
+	}
+	return provider.Refresh(ctx, refreshToken)
+}
+
+// Init sets the manager used by the dashboard routes.
+func Init(store Store, ttl time.Duration) {
+	defaultManager = NewManager(store, ttl)
+}
+
+// GetManager returns the manager configured by Init, or nil if sessions are not enabled.
+func GetManager() *Manager {
+	return defaultManager
+}
+
+// Create starts a new session for the given credentials.
+func (m *Manager) Create(ctx context.Context, user, token, refreshToken string, tokenExpiresAt time.Time) (*Session, error) {
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	now := m.now()
+	session := &Session{
+		ID:             id,
+		User:           user,
+		Token:          token,
+		RefreshToken:   refreshToken,
+		TokenExpiresAt: tokenExpiresAt,
+		CreatedAt:      now,
+		ExpiresAt:      now.Add(m.ttl),
+	}
+	if err = m.store.Save(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// Get returns the session with the given id. OIDC tokens close to expiry are refreshed on the way.
+func (m *Manager) Get(ctx context.Context, id string) (*Session, error) {
+	session, err := m.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	now := m.now()
+	if session.Expired(now) {
+		_ = m.store.Delete(ctx, id)
+		return nil, ErrNotFound
+	}
+	if !m.needsRefresh(session, now) {
+		return session, nil
+	}
+	return m.sharedRefresh(ctx, session, false)
+}
+
+// Refresh exchanges the refresh token of the session for a new token.
+func (m *Manager) Refresh(ctx context.Context, session *Session) error {
+	refreshed, err := m.sharedRefresh(ctx, session, true)
+	if err != nil {
+		return err
+	}
+	*session = *refreshed
+	return nil
+}
+
+func (m *Manager) needsRefresh(session *Session, now time.Time) bool {
+	return session.RefreshToken != "" && !session.TokenExpiresAt.IsZero() && now.Add(refreshBefore).After(session.TokenExpiresAt)
+}
+
+// sharedRefresh refreshes the session once for all concurrent callers. A failed refresh which is not
+// forced is only logged, the session keeps its current token until it expires.
+func (m *Manager) sharedRefresh(ctx context.Context, session *Session, force bool) (*Session, error) {
+	result, err, _ := m.refreshes.Do(session.ID, func() (interface{}, error) {
+		// a refresh which finished while this one waited has already rotated the refresh token
+		latest, err := m.store.Get(ctx, session.ID)
+		if err != nil {
+			return nil, err
+		}
+		if latest.RefreshToken != session.RefreshToken || (!force && !m.needsRefresh(latest, m.now())) {
+			return latest, nil
+		}
+		if err = m.refresh(ctx, latest); err != nil {
+			if force {
+				return nil, err
+			}
+			klog.ErrorS(err, "Could not refresh session token", "user", latest.User)
+		}
+		return latest, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// callers of the same flight share the result, hand out copies
+	refreshed := *result.(*Session)
+	return &refreshed, nil
+}
+
+func (m *Manager) refresh(ctx context.Context, session *Session) error {
+	token, err := m.refreshToken(ctx, session.RefreshToken)
+	if err != nil {
+		return err
+	}
+	session.Token = token.IDToken
+	session.RefreshToken = token.RefreshToken
+	session.TokenExpiresAt = token.Expiry
+	return m.store.Save(ctx, session)
+}
+
+// Revoke deletes the session with the given id.
+func (m *Manager) Revoke(ctx context.Context, id string) error {
+	return m.store.Delete(ctx, id)
+}
+
+// RevokeForUser deletes the session of the given user identified by its handle.
+func (m *Manager) RevokeForUser(ctx context.Context, user, handle string) error {
+	sessions, err := m.ListForUser(ctx, user)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if session.Handle() == handle {
+			return m.store.Delete(ctx, session.ID)
+		}
+	}
+	return ErrNotFound
+}
+
+// ListForUser returns the sessions of the given user.
+func (m *Manager) ListForUser(ctx context.Context, user string) ([]*Session, error) {
+	sessions, err := m.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*Session, 0)
+	for _, session := range sessions {
+		if session.User == user {
+			result = append(result, session)
+		}
+	}
+	return result, nil
+}
+
+// FromRequest returns the session referenced by the cookie of the request.
+func (m *Manager) FromRequest(request *http.Request) (*Session, error) {
+	cookie, err := request.Cookie(CookieName)
+	if err != nil || cookie.Value == "" {
+		return nil, ErrNotFound
+	}
+	return m.Get(request.Context(), cookie.Value)
+}
+
+// TokenFromRequest resolves the bearer token of the session referenced by the request cookie.
+// It has the signature of client.TokenResolver.
+func TokenFromRequest(request *http.Request) (string, bool) {
+	if defaultManager == nil {
+		return "", false
+	}
+	session, err := defaultManager.FromRequest(request)
+	if err != nil {
+		return "", false
+	}
+	return session.Token, session.Token != ""
+}
+
+// SetCookie writes the session cookie to the response.
+func SetCookie(w http.ResponseWriter, request *http.Request, session *Session) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     CookieName,
+		Value:    session.ID,
+		Path:     "/",
+		Expires:  session.ExpiresAt,
+		HttpOnly: true,
+		Secure:   isSecure(request),
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+// ClearCookie removes the session cookie from the browser.
+func ClearCookie(w http.ResponseWriter, request *http.Request) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     CookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   isSecure(request),
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+func isSecure(request *http.Request) bool {
+	return request.TLS != nil || request.Header.Get("X-Forwarded-Proto") == "https"
+}
+
+func newID() (string, error) {
+	buff := make([]byte, 32)
+	if _, err := rand.Read(buff); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buff), nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package session
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/karmada-io/dashboard/pkg/auth/oidc"
+)
+
+func TestManagerLifecycle(t *testing.T) {
+	now := time.Now()
+	manager := NewManager(NewMemoryStore(), time.Hour)
+	manager.now = func() time.Time { return now }
+	ctx := context.TODO()
+
+	first, err := manager.Create(ctx, "alice", "token-1", "", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := manager.Create(ctx, "alice", "token-2", "", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = manager.Create(ctx, "bob", "token-3", "", time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+
+	request := httptest.NewRequest("GET", "/api/v1/me", nil)
+	recorder := httptest.NewRecorder()
+	SetCookie(recorder, request, first)
+	request.AddCookie(recorder.Result().Cookies()[0])
+	got, err := manager.FromRequest(request)
+	if err != nil || got.Token != "token-1" {
+		t.Fatalf("FromRequest() = %v, %v, expected the first session", got, err)
+	}
+
+	sessions, err := manager.ListForUser(ctx, "alice")
+	if err != nil || len(sessions) != 2 {
+		t.Fatalf("ListForUser() = %d sessions, %v, expected 2", len(sessions), err)
+	}
+	if err = manager.RevokeForUser(ctx, "bob", second.Handle()); err != ErrNotFound {
+		t.Errorf("RevokeForUser() of another user's session returned %v, expected %v", err, ErrNotFound)
+	}
+	if err = manager.RevokeForUser(ctx, "alice", second.Handle()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = manager.Get(ctx, second.ID); err != ErrNotFound {
+		t.Errorf("Get() of a revoked session returned %v, expected %v", err, ErrNotFound)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, err = manager.Get(ctx, first.ID); err != ErrNotFound {
+		t.Errorf("Get() of an expired session returned %v, expected %v", err, ErrNotFound)
+	}
+}
+
+func TestManagerRefreshesOnce(t *testing.T) {
+	now := time.Now()
+	manager := NewManager(NewMemoryStore(), time.Hour)
+	manager.now = func() time.Time { return now }
+	ctx := context.TODO()
+
+	var mu sync.Mutex
+	used := map[string]bool{}
+	release := make(chan struct{})
+	manager.refreshToken = func(_ context.Context, refreshToken string) (*oidc.Token, error) {
+		<-release
+		mu.Lock()
+		defer mu.Unlock()
+		// a rotating provider accepts each refresh token once
+		if used[refreshToken] {
+			return nil, fmt.Errorf("refresh token %s was already used", refreshToken)
+		}
+		used[refreshToken] = true
+		return &oidc.Token{IDToken: "token-2", RefreshToken: "refresh-2", Expiry: now.Add(time.Hour)}, nil
+	}
+
+	s, err := manager.Create(ctx, "alice", "token-1", "refresh-1", now.Add(30*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := manager.Get(ctx, s.ID)
+			if err != nil || got.Token != "token-2" {
+				t.Errorf("Get() = %v, %v, expected the refreshed token", got, err)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if len(used) != 1 {
+		t.Errorf("expected one refresh, the provider saw %d refresh tokens", len(used))
+	}
+	if got, _ := manager.Get(ctx, s.ID); got.RefreshToken != "refresh-2" {
+		t.Errorf("expected the rotated refresh token to be stored, got %q", got.RefreshToken)
+	}
+}
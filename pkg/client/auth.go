@@ -17,7 +17,11 @@ limitations under the License.
 package client
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -33,7 +37,22 @@ const (
 	authorizationTokenPrefix = "Bearer "
 )
 
+// TokenResolver resolves the bearer token of a request which carries no authorization header,
+// e.g. from a session cookie. It returns false if the request has no token.
+type TokenResolver func(request *http.Request) (string, bool)
+
+var tokenResolver TokenResolver
+
+// SetTokenResolver registers the resolver consulted by GetBearerToken for requests without an
+// authorization header.
+func SetTokenResolver(resolver TokenResolver) {
+	tokenResolver = resolver
+}
+
 func karmadaConfigFromRequest(request *http.Request) (*rest.Config, error) {
+	if useServiceIdentity(request) {
+		return karmadaRestConfig, nil
+	}
 	authInfo, err := buildAuthInfo(request)
 	if err != nil {
 		return nil, err
@@ -42,7 +61,27 @@ func karmadaConfigFromRequest(request *http.Request) (*rest.Config, error) {
 	return buildConfigFromAuthInfo(authInfo)
 }
 
-func buildConfigFromAuthInfo(authInfo *clientcmdapi.AuthInfo) (*rest.Config, error) {
+// GetKarmadaConfigFromRequest returns the rest config and the kubeconfig of karmada apiserver,
+// both authenticating as the caller of the request, for callers which need a config rather than a client.
+func GetKarmadaConfigFromRequest(request *http.Request) (*rest.Config, *clientcmdapi.Config, error) {
+	if !isKarmadaInitialized() {
+		return nil, nil, fmt.Errorf("client package not initialized")
+	}
+	if useServiceIdentity(request) {
+		return karmadaRestConfig, karmadaAPIConfig, nil
+	}
+	authInfo, err := buildAuthInfo(request)
+	if err != nil {
+		return nil, nil, err
+	}
+	restConfig, err := buildConfigFromAuthInfo(authInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+	return restConfig, buildAPIConfigFromAuthInfo(authInfo), nil
+}
+
+func buildAPIConfigFromAuthInfo(authInfo *clientcmdapi.AuthInfo) *clientcmdapi.Config {
 	cmdCfg := clientcmdapi.NewConfig()
 
 	cmdCfg.Clusters[DefaultCmdConfigName] = &clientcmdapi.Cluster{
@@ -60,19 +99,29 @@ func buildConfigFromAuthInfo(authInfo *clientcmdapi.AuthInfo) (*rest.Config, err
 	}
 
 	cmdCfg.CurrentContext = DefaultCmdConfigName
+	return cmdCfg
+}
 
-	return clientcmd.NewDefaultClientConfig(
-		*cmdCfg,
+func buildConfigFromAuthInfo(authInfo *clientcmdapi.AuthInfo) (*rest.Config, error) {
+	restConfig, err := clientcmd.NewDefaultClientConfig(
+		*buildAPIConfigFromAuthInfo(authInfo),
 		&clientcmd.ConfigOverrides{},
 	).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	restConfig.QPS = karmadaRestConfig.QPS
+	restConfig.Burst = karmadaRestConfig.Burst
+	restConfig.UserAgent = karmadaRestConfig.UserAgent
+	return restConfig, nil
 }
 
 func buildAuthInfo(request *http.Request) (*clientcmdapi.AuthInfo, error) {
-	if !HasAuthorizationHeader(request) {
+	token := GetBearerToken(request)
+	if len(token) == 0 {
 		return nil, k8serrors.NewUnauthorized("MSG_LOGIN_UNAUTHORIZED_ERROR")
 	}
 
-	token := GetBearerToken(request)
 	authInfo := &clientcmdapi.AuthInfo{
 		Token:                token,
 		ImpersonateUserExtra: make(map[string][]string),
@@ -93,10 +142,18 @@ func HasAuthorizationHeader(req *http.Request) bool {
 	return strings.HasPrefix(header, authorizationTokenPrefix) && len(token) > 0
 }
 
-// GetBearerToken returns the bearer token from the authorization header.
+// GetBearerToken returns the bearer token from the authorization header. Requests without an
+// authorization header fall back to the registered TokenResolver, e.g. a session cookie.
 func GetBearerToken(req *http.Request) string {
-	header := req.Header.Get(authorizationHeader)
-	return extractBearerToken(header)
+	if HasAuthorizationHeader(req) {
+		return extractBearerToken(req.Header.Get(authorizationHeader))
+	}
+	if tokenResolver != nil {
+		if token, ok := tokenResolver(req); ok {
+			return token
+		}
+	}
+	return ""
 }
 
 // SetAuthorizationHeader sets the authorization header for the given request.
@@ -132,3 +189,37 @@ func handleImpersonation(authInfo *clientcmdapi.AuthInfo, request *http.Request)
 		}
 	}
 }
+
+// useServiceIdentity reports whether the request should be served with the dashboard's own
+// identity, which is only the case for requests without a bearer token when the service
+// identity fallback is enabled.
+func useServiceIdentity(request *http.Request) bool {
+	return serviceIdentityFallback && len(GetBearerToken(request)) == 0
+}
+
+// identityKey returns a key identifying the credentials of the given auth info, so clients can
+// be cached per user without keeping the raw token as map key.
+func identityKey(authInfo *clientcmdapi.AuthInfo) string {
+	hash := sha256.New()
+	write := func(s string) {
+		hash.Write([]byte(s))
+		hash.Write([]byte{0})
+	}
+	write(authInfo.Token)
+	write(authInfo.Impersonate)
+	for _, group := range authInfo.ImpersonateGroups {
+		write(group)
+	}
+	extraNames := make([]string, 0, len(authInfo.ImpersonateUserExtra))
+	for name := range authInfo.ImpersonateUserExtra {
+		extraNames = append(extraNames, name)
+	}
+	sort.Strings(extraNames)
+	for _, name := range extraNames {
+		write(name)
+		for _, value := range authInfo.ImpersonateUserExtra[name] {
+			write(value)
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
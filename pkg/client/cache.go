@@ -0,0 +1,124 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultClientCacheTTL is the default time a client built for a user stays cached.
+const DefaultClientCacheTTL = 10 * time.Minute
+
+type ttlCacheEntry[T any] struct {
+	value     T
+	expiresAt time.Time
+}
+
+// ttlCache caches values by key for a fixed amount of time. It is safe for concurrent use.
+type ttlCache[T any] struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	entries   map[string]*ttlCacheEntry[T]
+	nextSweep time.Time
+	now       func() time.Time
+	// flight makes concurrent misses of the same key build the value once
+	flight singleflight.Group
+}
+
+func newTTLCache[T any](ttl time.Duration) *ttlCache[T] {
+	if ttl <= 0 {
+		ttl = DefaultClientCacheTTL
+	}
+	return &ttlCache[T]{
+		ttl:     ttl,
+		entries: make(map[string]*ttlCacheEntry[T]),
+		now:     time.Now,
+	}
+}
+
+// getOrCreate returns the cached value for key, or builds, stores and returns a new one.
+// The value is built without holding the lock, so a slow build does not block other keys.
+func (c *ttlCache[T]) getOrCreate(key string, build func() (T, error)) (T, error) {
+	if value, ok := c.get(key); ok {
+		return value, nil
+	}
+
+	value, err, _ := c.flight.Do(key, func() (interface{}, error) {
+		// another flight may have stored the value since the lookup above
+		if value, ok := c.get(key); ok {
+			return value, nil
+		}
+		value, err := build()
+		if err != nil {
+			return nil, err
+		}
+		c.set(key, value)
+		return value, nil
+	})
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+	result, _ := value.(T)
+	return result, nil
+}
+
+// get returns the cached value for key, if it has not expired.
+func (c *ttlCache[T]) get(key string) (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[key]; ok && c.now().Before(entry.expiresAt) {
+		return entry.value, true
+	}
+	var empty T
+	return empty, false
+}
+
+// set stores value for key.
+func (c *ttlCache[T]) set(key string, value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	c.entries[key] = &ttlCacheEntry[T]{value: value, expiresAt: now.Add(c.ttl)}
+	c.sweepLocked(now)
+}
+
+// setTTL changes the ttl of entries added from now on.
+func (c *ttlCache[T]) setTTL(ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttl = ttl
+}
+
+// sweepLocked drops expired entries, at most once per ttl.
+func (c *ttlCache[T]) sweepLocked(now time.Time) {
+	if now.Before(c.nextSweep) {
+		return
+	}
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	c.nextSweep = now.Add(c.ttl)
+}
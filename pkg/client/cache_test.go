@@ -0,0 +1,98 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTTLCacheGetOrCreate(t *testing.T) {
+	now := time.Unix(0, 0)
+	cache := newTTLCache[int](time.Minute)
+	cache.now = func() time.Time { return now }
+
+	builds := 0
+	build := func() (int, error) {
+		builds++
+		return builds, nil
+	}
+
+	if v, _ := cache.getOrCreate("a", build); v != 1 {
+		t.Fatalf("getOrCreate() == %d, expected 1", v)
+	}
+	if v, _ := cache.getOrCreate("a", build); v != 1 {
+		t.Fatalf("getOrCreate() == %d, expected cached value 1", v)
+	}
+	if v, _ := cache.getOrCreate("b", build); v != 2 {
+		t.Fatalf("getOrCreate() == %d, expected 2", v)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if v, _ := cache.getOrCreate("a", build); v != 3 {
+		t.Fatalf("getOrCreate() == %d, expected expired entry to be rebuilt as 3", v)
+	}
+	if _, ok := cache.entries["b"]; ok {
+		t.Errorf("expected expired entry b to be swept")
+	}
+}
+
+func TestTTLCacheGetOrCreateDoesNotCacheErrors(t *testing.T) {
+	cache := newTTLCache[int](time.Minute)
+
+	if _, err := cache.getOrCreate("a", func() (int, error) { return 0, errors.New("boom") }); err == nil {
+		t.Fatalf("expected the build error to be returned")
+	}
+	if v, err := cache.getOrCreate("a", func() (int, error) { return 1, nil }); err != nil || v != 1 {
+		t.Fatalf("getOrCreate() == %d, %v, expected 1 after a failed build", v, err)
+	}
+}
+
+func TestTTLCacheGetOrCreateBuildsOnce(t *testing.T) {
+	cache := newTTLCache[int](time.Minute)
+
+	var builds atomic.Int32
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := cache.getOrCreate("a", func() (int, error) {
+				<-release
+				return int(builds.Add(1)), nil
+			})
+			if err != nil || v != 1 {
+				t.Errorf("getOrCreate() == %d, %v, expected 1", v, err)
+			}
+		}()
+	}
+
+	// a slow build of one key must not block other keys
+	if v, _ := cache.getOrCreate("b", func() (int, error) { return 2, nil }); v != 2 {
+		t.Errorf("getOrCreate() == %d, expected 2", v)
+	}
+	close(release)
+	wg.Wait()
+
+	if got := builds.Load(); got != 1 {
+		t.Errorf("expected concurrent misses to build once, built %d times", got)
+	}
+}
@@ -0,0 +1,473 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	clusterv1alpha1client "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/typed/cluster/v1alpha1"
+	policyv1alpha1client "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/typed/policy/v1alpha1"
+	workv1alpha2client "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/typed/work/v1alpha2"
+	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeclient "k8s.io/client-go/kubernetes"
+	appsv1client "k8s.io/client-go/kubernetes/typed/apps/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	networkingv1client "k8s.io/client-go/kubernetes/typed/networking/v1"
+	"k8s.io/klog/v2"
+)
+
+// cacheAccessReviewTTL is how long the decision whether a user may read a resource from the cache is
+// kept, revoked permissions stop cached reads after at most this long.
+const cacheAccessReviewTTL = time.Minute
+
+var cacheAccessReviews = newTTLCache[bool](cacheAccessReviewTTL)
+
+// GetCachedKarmadaClientFromRequest returns the clientset of GetKarmadaClientFromRequest, with lists
+// and gets of clusters, policies and resource bindings served from the resource cache. The cache is
+// only used if the user of the request may read the resource in the requested scope, everything else
+// goes to the apiserver with the credentials of the request.
+func GetCachedKarmadaClientFromRequest(request *http.Request) (karmadaclientset.Interface, error) {
+	karmadaClient, err := GetKarmadaClientFromRequest(request)
+	if err != nil || resourceCacheInstance == nil {
+		return karmadaClient, err
+	}
+	reader, err := newCachedReader(request)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedKarmadaClient{Interface: karmadaClient, reader: reader}, nil
+}
+
+// GetCachedKubeClientFromRequest returns the clientset of GetKubeClientFromRequest, with lists and gets
+// of namespaces, deployments, configmaps, services and ingresses served from the resource cache like
+// GetCachedKarmadaClientFromRequest does.
+func GetCachedKubeClientFromRequest(request *http.Request) (kubeclient.Interface, error) {
+	kubeClient, err := GetKubeClientFromRequest(request)
+	if err != nil || resourceCacheInstance == nil {
+		return kubeClient, err
+	}
+	reader, err := newCachedReader(request)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedKubeClient{Interface: kubeClient, reader: reader}, nil
+}
+
+// cachedReader decides for the user of a request which reads the resource cache may serve.
+type cachedReader struct {
+	cache     *resourceCache
+	identity  string
+	reviewer  kubeclient.Interface
+	freshness *cacheFreshness
+}
+
+func newCachedReader(request *http.Request) (*cachedReader, error) {
+	key := serviceIdentityKey
+	if !useServiceIdentity(request) {
+		authInfo, err := buildAuthInfo(request)
+		if err != nil {
+			return nil, err
+		}
+		key = identityKey(authInfo)
+	}
+	reviewer, err := GetKubeClientFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	freshness, _ := request.Context().Value(cacheFreshnessKey{}).(*cacheFreshness)
+	return &cachedReader{cache: resourceCacheInstance, identity: key, reviewer: reviewer, freshness: freshness}, nil
+}
+
+// canServe returns true if the synced cache of resource may serve the read, which a SelfSubjectAccessReview
+// of the user decides.
+func (r *cachedReader) canServe(ctx context.Context, resource *cachedResource, verb, namespace string) bool {
+	if !resource.informer.HasSynced() {
+		return false
+	}
+	key := strings.Join([]string{r.identity, verb, resource.resource.String(), namespace}, "/")
+	allowed, ok := cacheAccessReviews.get(key)
+	if !ok {
+		review, err := r.reviewer.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Verb:      verb,
+					Group:     resource.resource.Group,
+					Resource:  resource.resource.Resource,
+					Namespace: namespace,
+				},
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			klog.V(4).InfoS("Failed to review access to the resource cache", "resource", resource.resource, "err", err)
+			return false
+		}
+		allowed = review.Status.Allowed
+		cacheAccessReviews.set(key, allowed)
+	}
+	if allowed {
+		r.freshness.record(resource.freshness())
+	}
+	return allowed
+}
+
+// cacheableListOptions returns the label selector of opts, false if the cache can not serve the list.
+func cacheableListOptions(opts metav1.ListOptions) (labels.Selector, bool) {
+	if opts.FieldSelector != "" || opts.Limit > 0 || opts.Continue != "" || opts.Watch ||
+		(opts.ResourceVersion != "" && opts.ResourceVersion != "0") {
+		return nil, false
+	}
+	selector, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		// the apiserver reports the invalid selector
+		return nil, false
+	}
+	return selector, true
+}
+
+// listCached serves the list from the cache of resource, or calls direct if it can not.
+func listCached[L any, PL interface {
+	*L
+	runtime.Object
+}](ctx context.Context, r *cachedReader, resource *cachedResource, namespace string, opts metav1.ListOptions,
+	direct func(context.Context, metav1.ListOptions) (PL, error)) (PL, error) {
+	selector, ok := cacheableListOptions(opts)
+	if !ok || !r.canServe(ctx, resource, "list", namespace) {
+		return direct(ctx, opts)
+	}
+	objects, err := resource.list(namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+	list := PL(new(L))
+	if err := meta.SetList(list, objects); err != nil {
+		return nil, err
+	}
+	if listMeta, err := meta.ListAccessor(list); err == nil {
+		listMeta.SetResourceVersion(resource.informer.LastSyncResourceVersion())
+	}
+	return list, nil
+}
+
+// getCached serves the get from the cache of resource, or calls direct if it can not. Objects missing
+// from the cache are fetched too, they may have been created a moment ago.
+func getCached[T any, PT interface {
+	*T
+	runtime.Object
+}](ctx context.Context, r *cachedReader, resource *cachedResource, namespace, name string, opts metav1.GetOptions,
+	direct func(context.Context, string, metav1.GetOptions) (PT, error)) (PT, error) {
+	if (opts.ResourceVersion != "" && opts.ResourceVersion != "0") || !r.canServe(ctx, resource, "get", namespace) {
+		return direct(ctx, name, opts)
+	}
+	object, err := resource.get(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if typed, ok := object.(PT); ok {
+		return typed, nil
+	}
+	return direct(ctx, name, opts)
+}
+
+type cachedKarmadaClient struct {
+	karmadaclientset.Interface
+	reader *cachedReader
+}
+
+func (c *cachedKarmadaClient) ClusterV1alpha1() clusterv1alpha1client.ClusterV1alpha1Interface {
+	return &cachedClusterV1alpha1{ClusterV1alpha1Interface: c.Interface.ClusterV1alpha1(), reader: c.reader}
+}
+
+func (c *cachedKarmadaClient) PolicyV1alpha1() policyv1alpha1client.PolicyV1alpha1Interface {
+	return &cachedPolicyV1alpha1{PolicyV1alpha1Interface: c.Interface.PolicyV1alpha1(), reader: c.reader}
+}
+
+func (c *cachedKarmadaClient) WorkV1alpha2() workv1alpha2client.WorkV1alpha2Interface {
+	return &cachedWorkV1alpha2{WorkV1alpha2Interface: c.Interface.WorkV1alpha2(), reader: c.reader}
+}
+
+type cachedClusterV1alpha1 struct {
+	clusterv1alpha1client.ClusterV1alpha1Interface
+	reader *cachedReader
+}
+
+func (c *cachedClusterV1alpha1) Clusters() clusterv1alpha1client.ClusterInterface {
+	return &cachedClusters{ClusterInterface: c.ClusterV1alpha1Interface.Clusters(), reader: c.reader}
+}
+
+type cachedClusters struct {
+	clusterv1alpha1client.ClusterInterface
+	reader *cachedReader
+}
+
+func (c *cachedClusters) List(ctx context.Context, opts metav1.ListOptions) (*clusterv1alpha1.ClusterList, error) {
+	return listCached(ctx, c.reader, c.reader.cache.clusters, "", opts, c.ClusterInterface.List)
+}
+
+func (c *cachedClusters) Get(ctx context.Context, name string, opts metav1.GetOptions) (*clusterv1alpha1.Cluster, error) {
+	return getCached(ctx, c.reader, c.reader.cache.clusters, "", name, opts, c.ClusterInterface.Get)
+}
+
+type cachedPolicyV1alpha1 struct {
+	policyv1alpha1client.PolicyV1alpha1Interface
+	reader *cachedReader
+}
+
+func (c *cachedPolicyV1alpha1) PropagationPolicies(namespace string) policyv1alpha1client.PropagationPolicyInterface {
+	return &cachedPropagationPolicies{
+		PropagationPolicyInterface: c.PolicyV1alpha1Interface.PropagationPolicies(namespace),
+		reader:                     c.reader,
+		namespace:                  namespace,
+	}
+}
+
+func (c *cachedPolicyV1alpha1) ClusterPropagationPolicies() policyv1alpha1client.ClusterPropagationPolicyInterface {
+	return &cachedClusterPropagationPolicies{
+		ClusterPropagationPolicyInterface: c.PolicyV1alpha1Interface.ClusterPropagationPolicies(),
+		reader:                            c.reader,
+	}
+}
+
+func (c *cachedPolicyV1alpha1) OverridePolicies(namespace string) policyv1alpha1client.OverridePolicyInterface {
+	return &cachedOverridePolicies{
+		OverridePolicyInterface: c.PolicyV1alpha1Interface.OverridePolicies(namespace),
+		reader:                  c.reader,
+		namespace:               namespace,
+	}
+}
+
+func (c *cachedPolicyV1alpha1) ClusterOverridePolicies() policyv1alpha1client.ClusterOverridePolicyInterface {
+	return &cachedClusterOverridePolicies{
+		ClusterOverridePolicyInterface: c.PolicyV1alpha1Interface.ClusterOverridePolicies(),
+		reader:                         c.reader,
+	}
+}
+
+type cachedPropagationPolicies struct {
+	policyv1alpha1client.PropagationPolicyInterface
+	reader    *cachedReader
+	namespace string
+}
+
+func (c *cachedPropagationPolicies) List(ctx context.Context, opts metav1.ListOptions) (*policyv1alpha1.PropagationPolicyList, error) {
+	return listCached(ctx, c.reader, c.reader.cache.propagationPolicies, c.namespace, opts, c.PropagationPolicyInterface.List)
+}
+
+func (c *cachedPropagationPolicies) Get(ctx context.Context, name string, opts metav1.GetOptions) (*policyv1alpha1.PropagationPolicy, error) {
+	return getCached(ctx, c.reader, c.reader.cache.propagationPolicies, c.namespace, name, opts, c.PropagationPolicyInterface.Get)
+}
+
+type cachedClusterPropagationPolicies struct {
+	policyv1alpha1client.ClusterPropagationPolicyInterface
+	reader *cachedReader
+}
+
+func (c *cachedClusterPropagationPolicies) List(ctx context.Context, opts metav1.ListOptions) (*policyv1alpha1.ClusterPropagationPolicyList, error) {
+	return listCached(ctx, c.reader, c.reader.cache.clusterPropagationPolicies, "", opts, c.ClusterPropagationPolicyInterface.List)
+}
+
+func (c *cachedClusterPropagationPolicies) Get(ctx context.Context, name string, opts metav1.GetOptions) (*policyv1alpha1.ClusterPropagationPolicy, error) {
+	return getCached(ctx, c.reader, c.reader.cache.clusterPropagationPolicies, "", name, opts, c.ClusterPropagationPolicyInterface.Get)
+}
+
+type cachedOverridePolicies struct {
+	policyv1alpha1client.OverridePolicyInterface
+	reader    *cachedReader
+	namespace string
+}
+
+func (c *cachedOverridePolicies) List(ctx context.Context, opts metav1.ListOptions) (*policyv1alpha1.OverridePolicyList, error) {
+	return listCached(ctx, c.reader, c.reader.cache.overridePolicies, c.namespace, opts, c.OverridePolicyInterface.List)
+}
+
+func (c *cachedOverridePolicies) Get(ctx context.Context, name string, opts metav1.GetOptions) (*policyv1alpha1.OverridePolicy, error) {
+	return getCached(ctx, c.reader, c.reader.cache.overridePolicies, c.namespace, name, opts, c.OverridePolicyInterface.Get)
+}
+
+type cachedClusterOverridePolicies struct {
+	policyv1alpha1client.ClusterOverridePolicyInterface
+	reader *cachedReader
+}
+
+func (c *cachedClusterOverridePolicies) List(ctx context.Context, opts metav1.ListOptions) (*policyv1alpha1.ClusterOverridePolicyList, error) {
+	return listCached(ctx, c.reader, c.reader.cache.clusterOverridePolicies, "", opts, c.ClusterOverridePolicyInterface.List)
+}
+
+func (c *cachedClusterOverridePolicies) Get(ctx context.Context, name string, opts metav1.GetOptions) (*policyv1alpha1.ClusterOverridePolicy, error) {
+	return getCached(ctx, c.reader, c.reader.cache.clusterOverridePolicies, "", name, opts, c.ClusterOverridePolicyInterface.Get)
+}
+
+type cachedWorkV1alpha2 struct {
+	workv1alpha2client.WorkV1alpha2Interface
+	reader *cachedReader
+}
+
+func (c *cachedWorkV1alpha2) ResourceBindings(namespace string) workv1alpha2client.ResourceBindingInterface {
+	return &cachedResourceBindings{
+		ResourceBindingInterface: c.WorkV1alpha2Interface.ResourceBindings(namespace),
+		reader:                   c.reader,
+		namespace:                namespace,
+	}
+}
+
+type cachedResourceBindings struct {
+	workv1alpha2client.ResourceBindingInterface
+	reader    *cachedReader
+	namespace string
+}
+
+func (c *cachedResourceBindings) List(ctx context.Context, opts metav1.ListOptions) (*workv1alpha2.ResourceBindingList, error) {
+	return listCached(ctx, c.reader, c.reader.cache.resourceBindings, c.namespace, opts, c.ResourceBindingInterface.List)
+}
+
+func (c *cachedResourceBindings) Get(ctx context.Context, name string, opts metav1.GetOptions) (*workv1alpha2.ResourceBinding, error) {
+	return getCached(ctx, c.reader, c.reader.cache.resourceBindings, c.namespace, name, opts, c.ResourceBindingInterface.Get)
+}
+
+type cachedKubeClient struct {
+	kubeclient.Interface
+	reader *cachedReader
+}
+
+func (c *cachedKubeClient) CoreV1() corev1client.CoreV1Interface {
+	return &cachedCoreV1{CoreV1Interface: c.Interface.CoreV1(), reader: c.reader}
+}
+
+func (c *cachedKubeClient) AppsV1() appsv1client.AppsV1Interface {
+	return &cachedAppsV1{AppsV1Interface: c.Interface.AppsV1(), reader: c.reader}
+}
+
+func (c *cachedKubeClient) NetworkingV1() networkingv1client.NetworkingV1Interface {
+	return &cachedNetworkingV1{NetworkingV1Interface: c.Interface.NetworkingV1(), reader: c.reader}
+}
+
+type cachedCoreV1 struct {
+	corev1client.CoreV1Interface
+	reader *cachedReader
+}
+
+func (c *cachedCoreV1) Namespaces() corev1client.NamespaceInterface {
+	return &cachedNamespaces{NamespaceInterface: c.CoreV1Interface.Namespaces(), reader: c.reader}
+}
+
+func (c *cachedCoreV1) ConfigMaps(namespace string) corev1client.ConfigMapInterface {
+	return &cachedConfigMaps{ConfigMapInterface: c.CoreV1Interface.ConfigMaps(namespace), reader: c.reader, namespace: namespace}
+}
+
+func (c *cachedCoreV1) Services(namespace string) corev1client.ServiceInterface {
+	return &cachedServices{ServiceInterface: c.CoreV1Interface.Services(namespace), reader: c.reader, namespace: namespace}
+}
+
+type cachedNamespaces struct {
+	corev1client.NamespaceInterface
+	reader *cachedReader
+}
+
+func (c *cachedNamespaces) List(ctx context.Context, opts metav1.ListOptions) (*corev1.NamespaceList, error) {
+	return listCached(ctx, c.reader, c.reader.cache.namespaces, "", opts, c.NamespaceInterface.List)
+}
+
+func (c *cachedNamespaces) Get(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.Namespace, error) {
+	return getCached(ctx, c.reader, c.reader.cache.namespaces, "", name, opts, c.NamespaceInterface.Get)
+}
+
+type cachedConfigMaps struct {
+	corev1client.ConfigMapInterface
+	reader    *cachedReader
+	namespace string
+}
+
+func (c *cachedConfigMaps) List(ctx context.Context, opts metav1.ListOptions) (*corev1.ConfigMapList, error) {
+	return listCached(ctx, c.reader, c.reader.cache.configMaps, c.namespace, opts, c.ConfigMapInterface.List)
+}
+
+func (c *cachedConfigMaps) Get(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.ConfigMap, error) {
+	return getCached(ctx, c.reader, c.reader.cache.configMaps, c.namespace, name, opts, c.ConfigMapInterface.Get)
+}
+
+type cachedServices struct {
+	corev1client.ServiceInterface
+	reader    *cachedReader
+	namespace string
+}
+
+func (c *cachedServices) List(ctx context.Context, opts metav1.ListOptions) (*corev1.ServiceList, error) {
+	return listCached(ctx, c.reader, c.reader.cache.services, c.namespace, opts, c.ServiceInterface.List)
+}
+
+func (c *cachedServices) Get(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.Service, error) {
+	return getCached(ctx, c.reader, c.reader.cache.services, c.namespace, name, opts, c.ServiceInterface.Get)
+}
+
+type cachedAppsV1 struct {
+	appsv1client.AppsV1Interface
+	reader *cachedReader
+}
+
+func (c *cachedAppsV1) Deployments(namespace string) appsv1client.DeploymentInterface {
+	return &cachedDeployments{DeploymentInterface: c.AppsV1Interface.Deployments(namespace), reader: c.reader, namespace: namespace}
+}
+
+type cachedDeployments struct {
+	appsv1client.DeploymentInterface
+	reader    *cachedReader
+	namespace string
+}
+
+func (c *cachedDeployments) List(ctx context.Context, opts metav1.ListOptions) (*appsv1.DeploymentList, error) {
+	return listCached(ctx, c.reader, c.reader.cache.deployments, c.namespace, opts, c.DeploymentInterface.List)
+}
+
+func (c *cachedDeployments) Get(ctx context.Context, name string, opts metav1.GetOptions) (*appsv1.Deployment, error) {
+	return getCached(ctx, c.reader, c.reader.cache.deployments, c.namespace, name, opts, c.DeploymentInterface.Get)
+}
+
+type cachedNetworkingV1 struct {
+	networkingv1client.NetworkingV1Interface
+	reader *cachedReader
+}
+
+func (c *cachedNetworkingV1) Ingresses(namespace string) networkingv1client.IngressInterface {
+	return &cachedIngresses{IngressInterface: c.NetworkingV1Interface.Ingresses(namespace), reader: c.reader, namespace: namespace}
+}
+
+type cachedIngresses struct {
+	networkingv1client.IngressInterface
+	reader    *cachedReader
+	namespace string
+}
+
+func (c *cachedIngresses) List(ctx context.Context, opts metav1.ListOptions) (*networkingv1.IngressList, error) {
+	return listCached(ctx, c.reader, c.reader.cache.ingresses, c.namespace, opts, c.IngressInterface.List)
+}
+
+func (c *cachedIngresses) Get(ctx context.Context, name string, opts metav1.GetOptions) (*networkingv1.Ingress, error) {
+	return getCached(ctx, c.reader, c.reader.cache.ingresses, c.namespace, name, opts, c.IngressInterface.Get)
+}
@@ -109,19 +109,98 @@ func KubeClientSetFromKubeConfig(kubeconfig string) (*kubeclient.Clientset, erro
 	return kubeClient, nil
 }
 
+// userClients holds the clients built for a single identity.
+type userClients struct {
+	karmada karmadaclientset.Interface
+	kube    kubeclient.Interface
+}
+
+var (
+	requestClients          = newTTLCache[*userClients](DefaultClientCacheTTL)
+	requestMemberClients    = newTTLCache[kubeclient.Interface](DefaultClientCacheTTL)
+	serviceIdentityFallback bool
+)
+
 // GetKarmadaClientFromRequest creates a Karmada clientset from an HTTP request.
+// Clients are cached per identity, so repeated requests with the same token reuse them.
 func GetKarmadaClientFromRequest(request *http.Request) (karmadaclientset.Interface, error) {
 	if !isKarmadaInitialized() {
 		return nil, fmt.Errorf("client package not initialized")
 	}
-	return karmadaClientFromRequest(request)
+	if useServiceIdentity(request) {
+		return InClusterKarmadaClient(), nil
+	}
+	clients, err := userClientsFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	return clients.karmada, nil
 }
 
-func karmadaClientFromRequest(request *http.Request) (karmadaclientset.Interface, error) {
-	config, err := karmadaConfigFromRequest(request)
+// GetKubeClientFromRequest creates a kubernetes clientset for karmada apiserver from an HTTP request.
+// Clients are cached per identity, so repeated requests with the same token reuse them.
+func GetKubeClientFromRequest(request *http.Request) (kubeclient.Interface, error) {
+	if !isKarmadaInitialized() {
+		return nil, fmt.Errorf("client package not initialized")
+	}
+	if useServiceIdentity(request) {
+		return InClusterClientForKarmadaAPIServer(), nil
+	}
+	clients, err := userClientsFromRequest(request)
 	if err != nil {
 		return nil, err
 	}
+	return clients.kube, nil
+}
 
-	return karmadaclientset.NewForConfig(config)
+func userClientsFromRequest(request *http.Request) (*userClients, error) {
+	authInfo, err := buildAuthInfo(request)
+	if err != nil {
+		return nil, err
+	}
+	return requestClients.getOrCreate(identityKey(authInfo), func() (*userClients, error) {
+		config, err := buildConfigFromAuthInfo(authInfo)
+		if err != nil {
+			return nil, err
+		}
+		karmadaClient, err := karmadaclientset.NewForConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		kubeClient, err := kubeclient.NewForConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		return &userClients{karmada: karmadaClient, kube: kubeClient}, nil
+	})
+}
+
+// GetMemberClientFromRequest creates a kubernetes clientset for the given member cluster from an HTTP request.
+// Requests go through the karmada cluster proxy with the bearer token and impersonation headers of the
+// request, so the `clusters/proxy` RBAC of karmada applies to each user. Clients are cached per cluster
+// and identity.
+func GetMemberClientFromRequest(request *http.Request, clusterName string) (kubeclient.Interface, error) {
+	if !isKarmadaInitialized() {
+		return nil, fmt.Errorf("client package not initialized")
+	}
+	if useServiceIdentity(request) {
+		memberClient := InClusterClientForMemberCluster(clusterName)
+		if memberClient == nil {
+			return nil, fmt.Errorf("could not init client for member cluster %s", clusterName)
+		}
+		return memberClient, nil
+	}
+	authInfo, err := buildAuthInfo(request)
+	if err != nil {
+		return nil, err
+	}
+	key := clusterName + "/" + identityKey(authInfo)
+	return requestMemberClients.getOrCreate(key, func() (kubeclient.Interface, error) {
+		config, err := buildConfigFromAuthInfo(authInfo)
+		if err != nil {
+			return nil, err
+		}
+		config.Host = memberProxyHost(clusterName)
+		return kubeclient.NewForConfig(config)
+	})
 }
@@ -0,0 +1,94 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestGetMemberClientFromRequest(t *testing.T) {
+	now := time.Unix(0, 0)
+	karmadaRestConfig, karmadaAPIConfig = &rest.Config{Host: "https://karmada.example"}, clientcmdapi.NewConfig()
+	requestMemberClients = newTTLCache[kubeclient.Interface](time.Minute)
+	requestMemberClients.now = func() time.Time { return now }
+	t.Cleanup(func() {
+		karmadaRestConfig, karmadaAPIConfig = nil, nil
+		requestMemberClients = newTTLCache[kubeclient.Interface](DefaultClientCacheTTL)
+	})
+
+	request := func(token string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/member/member1/pod", nil)
+		SetAuthorizationHeader(r, token)
+		return r
+	}
+	get := func(token, clusterName string) kubeclient.Interface {
+		c, err := GetMemberClientFromRequest(request(token), clusterName)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return c
+	}
+
+	alice := get("alice", "member1")
+	if get("alice", "member1") != alice {
+		t.Errorf("expected the cached client to be reused for the same identity")
+	}
+	if get("bob", "member1") == alice {
+		t.Errorf("expected a different identity to get its own client")
+	}
+	if get("alice", "member2") == alice {
+		t.Errorf("expected a different cluster to get its own client")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if get("alice", "member1") == alice {
+		t.Errorf("expected the client to be rebuilt after the ttl")
+	}
+}
+
+func TestGetKarmadaConfigFromRequest(t *testing.T) {
+	karmadaRestConfig, karmadaAPIConfig = &rest.Config{Host: "https://karmada.example"}, clientcmdapi.NewConfig()
+	t.Cleanup(func() {
+		karmadaRestConfig, karmadaAPIConfig = nil, nil
+	})
+
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/cluster", nil)
+	if _, _, err := GetKarmadaConfigFromRequest(request); !k8serrors.IsUnauthorized(err) {
+		t.Fatalf("expected a request without credentials to be unauthorized, got %v", err)
+	}
+
+	SetAuthorizationHeader(request, "alice")
+	restConfig, apiConfig, err := GetKarmadaConfigFromRequest(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if restConfig.BearerToken != "alice" || restConfig.Host != karmadaRestConfig.Host {
+		t.Errorf("expected the rest config to use the caller's token against %s, got %q against %s",
+			karmadaRestConfig.Host, restConfig.BearerToken, restConfig.Host)
+	}
+	if authInfo := apiConfig.AuthInfos[apiConfig.Contexts[apiConfig.CurrentContext].AuthInfo]; authInfo.Token != "alice" {
+		t.Errorf("expected the kubeconfig to use the caller's token, got %q", authInfo.Token)
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/http"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// serviceIdentityKey is the cache key of requests served with the dashboard's own identity.
+const serviceIdentityKey = "service-identity"
+
+var requestUserInfos = newTTLCache[*authenticationv1.UserInfo](DefaultClientCacheTTL)
+
+// GetUserInfo returns the user the karmada apiserver authenticates the request as, as reported by a
+// SelfSubjectReview. Results are cached per identity like the clients.
+func GetUserInfo(request *http.Request) (*authenticationv1.UserInfo, error) {
+	key := serviceIdentityKey
+	if !useServiceIdentity(request) {
+		authInfo, err := buildAuthInfo(request)
+		if err != nil {
+			return nil, err
+		}
+		key = identityKey(authInfo)
+	}
+	if userInfo, ok := requestUserInfos.get(key); ok {
+		return userInfo, nil
+	}
+
+	kubeClient, err := GetKubeClientFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	review, err := kubeClient.AuthenticationV1().SelfSubjectReviews().Create(request.Context(), &authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	userInfo := review.Status.UserInfo.DeepCopy()
+	requestUserInfos.set(key, userInfo)
+	return userInfo, nil
+}
+
+// IsAllowed asks the karmada apiserver with a SelfSubjectAccessReview whether the user of the request
+// may perform the action described by attributes.
+func IsAllowed(request *http.Request, attributes *authorizationv1.ResourceAttributes) (bool, error) {
+	kubeClient, err := GetKubeClientFromRequest(request)
+	if err != nil {
+		return false, err
+	}
+	review, err := kubeClient.AuthorizationV1().SelfSubjectAccessReviews().Create(request.Context(), &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: attributes},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
 	kubeclient "k8s.io/client-go/kubernetes"
@@ -40,15 +41,17 @@ var (
 	karmadaMemberConfig                *rest.Config
 	inClusterKarmadaClient             karmadaclientset.Interface
 	inClusterClientForKarmadaAPIServer kubeclient.Interface
-	inClusterClientForMemberAPIServer  kubeclient.Interface
 	memberClients                      sync.Map
 )
 
 type configBuilder struct {
-	kubeconfigPath string
-	kubeContext    string
-	insecure       bool
-	userAgent      string
+	kubeconfigPath          string
+	kubeContext             string
+	insecure                bool
+	userAgent               string
+	clientCacheTTL          time.Duration
+	serviceIdentityFallback bool
+	namespaceTenancy        bool
 }
 
 // Option is a function that configures a configBuilder.
@@ -82,6 +85,30 @@ func WithInsecureTLSSkipVerify(insecure bool) Option {
 	}
 }
 
+// WithClientCacheTTL is an option to set how long clients built for a user are cached.
+// It is only used by InitKarmadaConfig.
+func WithClientCacheTTL(ttl time.Duration) Option {
+	return func(c *configBuilder) {
+		c.clientCacheTTL = ttl
+	}
+}
+
+// WithNamespaceTenancy is an option to restrict namespaced views to the namespaces each user can see.
+func WithNamespaceTenancy(enabled bool) Option {
+	return func(c *configBuilder) {
+		c.namespaceTenancy = enabled
+	}
+}
+
+// WithServiceIdentityFallback is an option to let requests without a bearer token use the
+// dashboard's own identity. It is meant for trusted single-user installs and is only used
+// by InitKarmadaConfig.
+func WithServiceIdentityFallback(enabled bool) Option {
+	return func(c *configBuilder) {
+		c.serviceIdentityFallback = enabled
+	}
+}
+
 func newConfigBuilder(options ...Option) *configBuilder {
 	builder := &configBuilder{}
 
@@ -223,6 +250,15 @@ func InitKarmadaConfig(options ...Option) {
 		os.Exit(1)
 	}
 	karmadaMemberConfig = memberConfig
+
+	requestClients.setTTL(builder.clientCacheTTL)
+	requestMemberClients.setTTL(builder.clientCacheTTL)
+	requestUserInfos.setTTL(builder.clientCacheTTL)
+	serviceIdentityFallback = builder.serviceIdentityFallback
+	namespaceTenancy = builder.namespaceTenancy
+	if serviceIdentityFallback {
+		klog.Warning("Requests without a bearer token will use the dashboard's own identity for the karmada apiserver")
+	}
 }
 
 // InClusterKarmadaClient returns a karmada client.
@@ -282,7 +318,8 @@ func InClusterClientForKarmadaAPIServer() kubeclient.Interface {
 	return inClusterClientForKarmadaAPIServer
 }
 
-// InClusterClientForMemberCluster returns a kubernetes client for member apiserver.
+// InClusterClientForMemberCluster returns a kubernetes client for member apiserver which acts
+// with the dashboard's own identity.
 func InClusterClientForMemberCluster(clusterName string) kubeclient.Interface {
 	if !isKarmadaInitialized() {
 		return nil
@@ -290,33 +327,33 @@ func InClusterClientForMemberCluster(clusterName string) kubeclient.Interface {
 
 	// Load and return Interface for member apiserver if already exist
 	if value, ok := memberClients.Load(clusterName); ok {
-		if inClusterClientForMemberAPIServer, ok = value.(kubeclient.Interface); ok {
-			return inClusterClientForMemberAPIServer
+		if memberClient, ok := value.(kubeclient.Interface); ok {
+			return memberClient
 		}
 		klog.Error("Could not get client for member apiserver")
 		return nil
 	}
 
 	// Client for new member apiserver
-	restConfig, _, err := GetKarmadaConfig()
-	if err != nil {
-		klog.ErrorS(err, "Could not get karmada restConfig")
-		return nil
-	}
 	memberConfig, err := GetMemberConfig()
 	if err != nil {
 		klog.ErrorS(err, "Could not get member restConfig")
 		return nil
 	}
-	memberConfig.Host = restConfig.Host + fmt.Sprintf(proxyURL, clusterName)
+	memberConfig = rest.CopyConfig(memberConfig)
+	memberConfig.Host = memberProxyHost(clusterName)
 	c, err := kubeclient.NewForConfig(memberConfig)
 	if err != nil {
 		klog.ErrorS(err, "Could not init kubernetes in-cluster client for member apiserver")
 		return nil
 	}
-	inClusterClientForMemberAPIServer = c
-	memberClients.Store(clusterName, inClusterClientForMemberAPIServer)
-	return inClusterClientForMemberAPIServer
+	actual, _ := memberClients.LoadOrStore(clusterName, kubeclient.Interface(c))
+	return actual.(kubeclient.Interface)
+}
+
+// memberProxyHost returns the address of the karmada cluster proxy for the given member cluster.
+func memberProxyHost(clusterName string) string {
+	return karmadaRestConfig.Host + fmt.Sprintf(proxyURL, clusterName)
 }
 
 // ConvertRestConfigToAPIConfig converts a rest.Config to a clientcmdapi.Config.
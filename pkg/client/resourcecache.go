@@ -0,0 +1,253 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	karmadainformers "github.com/karmada-io/karmada/pkg/generated/informers/externalversions"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeinformers "k8s.io/client-go/informers"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+// resourceCache is the shared informer cache of the control plane resources shown in lists, details
+// and the overview. Secrets are left out, so that their data is not kept in memory.
+type resourceCache struct {
+	clusters                   *cachedResource
+	propagationPolicies        *cachedResource
+	clusterPropagationPolicies *cachedResource
+	overridePolicies           *cachedResource
+	clusterOverridePolicies    *cachedResource
+	resourceBindings           *cachedResource
+	namespaces                 *cachedResource
+	deployments                *cachedResource
+	configMaps                 *cachedResource
+	services                   *cachedResource
+	ingresses                  *cachedResource
+
+	karmadaFactory karmadainformers.SharedInformerFactory
+	kubeFactory    kubeinformers.SharedInformerFactory
+	all            []*cachedResource
+}
+
+// resourceCacheInstance is nil if the cache is bypassed.
+var resourceCacheInstance *resourceCache
+
+func newResourceCache(karmadaClient karmadaclientset.Interface, kubeClient kubeclient.Interface) *resourceCache {
+	karmadaFactory := karmadainformers.NewSharedInformerFactory(karmadaClient, 0)
+	kubeFactory := kubeinformers.NewSharedInformerFactory(kubeClient, 0)
+	c := &resourceCache{karmadaFactory: karmadaFactory, kubeFactory: kubeFactory}
+	add := func(group, resource string, informer cache.SharedIndexInformer) *cachedResource {
+		r := newCachedResource(schema.GroupResource{Group: group, Resource: resource}, informer)
+		c.all = append(c.all, r)
+		return r
+	}
+	c.clusters = add("cluster.karmada.io", "clusters", karmadaFactory.Cluster().V1alpha1().Clusters().Informer())
+	c.propagationPolicies = add("policy.karmada.io", "propagationpolicies", karmadaFactory.Policy().V1alpha1().PropagationPolicies().Informer())
+	c.clusterPropagationPolicies = add("policy.karmada.io", "clusterpropagationpolicies", karmadaFactory.Policy().V1alpha1().ClusterPropagationPolicies().Informer())
+	c.overridePolicies = add("policy.karmada.io", "overridepolicies", karmadaFactory.Policy().V1alpha1().OverridePolicies().Informer())
+	c.clusterOverridePolicies = add("policy.karmada.io", "clusteroverridepolicies", karmadaFactory.Policy().V1alpha1().ClusterOverridePolicies().Informer())
+	c.resourceBindings = add("work.karmada.io", "resourcebindings", karmadaFactory.Work().V1alpha2().ResourceBindings().Informer())
+	c.namespaces = add("", "namespaces", kubeFactory.Core().V1().Namespaces().Informer())
+	c.deployments = add("apps", "deployments", kubeFactory.Apps().V1().Deployments().Informer())
+	c.configMaps = add("", "configmaps", kubeFactory.Core().V1().ConfigMaps().Informer())
+	c.services = add("", "services", kubeFactory.Core().V1().Services().Informer())
+	c.ingresses = add("networking.k8s.io", "ingresses", kubeFactory.Networking().V1().Ingresses().Informer())
+	return c
+}
+
+func (c *resourceCache) start(stopCh <-chan struct{}) {
+	c.karmadaFactory.Start(stopCh)
+	c.kubeFactory.Start(stopCh)
+}
+
+func (c *resourceCache) hasSynced() bool {
+	for _, r := range c.all {
+		if !r.informer.HasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+// InitResourceCache starts the informers of the resource cache for the karmada apiserver. Until it is
+// called, or if the cache is bypassed, all reads go to the apiserver.
+func InitResourceCache(stopCh <-chan struct{}) {
+	c := newResourceCache(InClusterKarmadaClient(), InClusterClientForKarmadaAPIServer())
+	c.start(stopCh)
+	resourceCacheInstance = c
+	go func() {
+		if cache.WaitForCacheSync(stopCh, c.hasSynced) {
+			klog.InfoS("Resource cache synced")
+		}
+	}()
+}
+
+// ResourceCacheSynced returns false while the informers of the resource cache have not synced yet.
+// A bypassed cache counts as synced.
+func ResourceCacheSynced() bool {
+	return resourceCacheInstance == nil || resourceCacheInstance.hasSynced()
+}
+
+// cachedResource is a resource kept by a shared informer.
+type cachedResource struct {
+	resource schema.GroupResource
+	informer cache.SharedIndexInformer
+	now      func() time.Time
+
+	mu sync.Mutex
+	// watching is true while the watch of the informer is believed to be up.
+	watching bool
+	// lastSeen is the last time the informer heard from the apiserver.
+	lastSeen time.Time
+}
+
+func newCachedResource(resource schema.GroupResource, informer cache.SharedIndexInformer) *cachedResource {
+	r := &cachedResource{resource: resource, informer: informer, now: time.Now}
+	seen := func(interface{}) { r.seen() }
+	_, _ = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    seen,
+		UpdateFunc: func(_, _ interface{}) { r.seen() },
+		DeleteFunc: seen,
+	})
+	_ = informer.SetWatchErrorHandler(func(reflector *cache.Reflector, err error) {
+		r.mu.Lock()
+		r.watching = false
+		r.mu.Unlock()
+		cache.DefaultWatchErrorHandler(reflector, err)
+	})
+	return r
+}
+
+func (r *cachedResource) seen() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.watching = true
+	r.lastSeen = r.now()
+}
+
+// freshness returns the time up to which the cached objects are known to be current: now while the
+// watch is up, the last event before it broke otherwise.
+func (r *cachedResource) freshness() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.watching || r.lastSeen.IsZero() {
+		// an empty resource has no events, its watch counts as up once synced
+		return r.now()
+	}
+	return r.lastSeen
+}
+
+// list returns copies of the cached objects in namespace, all namespaces if empty, matching selector
+// and sorted like the apiserver sorts them.
+func (r *cachedResource) list(namespace string, selector labels.Selector) ([]runtime.Object, error) {
+	var items []interface{}
+	if namespace == "" {
+		items = r.informer.GetIndexer().List()
+	} else {
+		var err error
+		items, err = r.informer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+	objects := make([]runtime.Object, 0, len(items))
+	for _, item := range items {
+		object, ok := item.(runtime.Object)
+		if !ok {
+			continue
+		}
+		accessor, err := meta.Accessor(object)
+		if err != nil || !selector.Matches(labels.Set(accessor.GetLabels())) {
+			continue
+		}
+		objects = append(objects, object.DeepCopyObject())
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		a, _ := meta.Accessor(objects[i])
+		b, _ := meta.Accessor(objects[j])
+		if a.GetNamespace() != b.GetNamespace() {
+			return a.GetNamespace() < b.GetNamespace()
+		}
+		return a.GetName() < b.GetName()
+	})
+	return objects, nil
+}
+
+// get returns a copy of the cached object, nil if it is not in the cache.
+func (r *cachedResource) get(namespace, name string) (runtime.Object, error) {
+	key := name
+	if namespace != "" {
+		key = namespace + "/" + name
+	}
+	item, exists, err := r.informer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return nil, err
+	}
+	object, ok := item.(runtime.Object)
+	if !ok {
+		return nil, nil
+	}
+	return object.DeepCopyObject(), nil
+}
+
+type cacheFreshnessKey struct{}
+
+// cacheFreshness records the oldest freshness of the cached resources a request read.
+type cacheFreshness struct {
+	mu     sync.Mutex
+	oldest time.Time
+}
+
+func (f *cacheFreshness) record(freshness time.Time) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.oldest.IsZero() || freshness.Before(f.oldest) {
+		f.oldest = freshness
+	}
+}
+
+// WithCacheFreshness returns a copy of ctx in which the clients of GetCachedKarmadaClientFromRequest and
+// GetCachedKubeClientFromRequest record the freshness of the cached data they return.
+func WithCacheFreshness(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheFreshnessKey{}, &cacheFreshness{})
+}
+
+// CacheFreshness returns the time up to which all cached data read for the request is known to be
+// current, false if nothing was read from the cache.
+func CacheFreshness(ctx context.Context) (time.Time, bool) {
+	f, _ := ctx.Value(cacheFreshnessKey{}).(*cacheFreshness)
+	if f == nil {
+		return time.Time{}, false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.oldest, !f.oldest.IsZero()
+}
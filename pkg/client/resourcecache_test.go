@@ -0,0 +1,103 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	karmadafake "github.com/karmada-io/karmada/pkg/generated/clientset/versioned/fake"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestCachedReader(t *testing.T) {
+	karmadaClient := karmadafake.NewSimpleClientset(&clusterv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "member1"}})
+	kubeClient := kubefake.NewSimpleClientset(
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "a", Name: "a1", Labels: map[string]string{"app": "x"}}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "a", Name: "a2"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "b", Name: "b1"}},
+	)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	resources := newResourceCache(karmadaClient, kubeClient)
+	resources.start(stopCh)
+	if !cache.WaitForCacheSync(stopCh, resources.hasSynced) {
+		t.Fatalf("resource cache did not sync")
+	}
+
+	// the user may read configmaps in namespace a only
+	reviewer := kubefake.NewSimpleClientset()
+	reviewer.PrependReactor("create", "selfsubjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		review := action.(clienttesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		attributes := review.Spec.ResourceAttributes
+		review.Status.Allowed = attributes.Resource == "clusters" || attributes.Namespace == "a"
+		return true, review, nil
+	})
+	freshness := &cacheFreshness{}
+	reader := &cachedReader{cache: resources, identity: t.Name(), reviewer: reviewer, freshness: freshness}
+	cachedKube := &cachedKubeClient{Interface: kubeClient, reader: reader}
+	cachedKarmada := &cachedKarmadaClient{Interface: karmadaClient, reader: reader}
+	ctx := context.TODO()
+
+	kubeClient.ClearActions()
+	list, err := cachedKube.CoreV1().ConfigMaps("a").List(ctx, metav1.ListOptions{LabelSelector: "app=x"})
+	if err != nil || len(list.Items) != 1 || list.Items[0].Name != "a1" {
+		t.Fatalf("expected a1 from the cache, got %v, %v", list, err)
+	}
+	if _, err := cachedKarmada.ClusterV1alpha1().Clusters().Get(ctx, "member1", metav1.GetOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(kubeClient.Actions()) != 0 {
+		t.Errorf("expected cached reads, got %v", kubeClient.Actions())
+	}
+	if _, ok := CacheFreshness(context.WithValue(ctx, cacheFreshnessKey{}, freshness)); !ok {
+		t.Errorf("expected the freshness of the cached reads to be recorded")
+	}
+
+	// reads the user may not do from the cache go to the apiserver with the user's credentials
+	if _, err := cachedKube.CoreV1().ConfigMaps("").List(ctx, metav1.ListOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := cachedKube.CoreV1().ConfigMaps("a").List(ctx, metav1.ListOptions{Limit: 1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if actions := kubeClient.Actions(); len(actions) != 2 {
+		t.Errorf("expected the list of all namespaces and the paginated list to be passed through, got %v", actions)
+	}
+}
+
+func TestCachedResourceFreshness(t *testing.T) {
+	now := time.Unix(100, 0)
+	r := &cachedResource{now: func() time.Time { return now }}
+	if !r.freshness().Equal(now) {
+		t.Errorf("expected a resource without events to be fresh")
+	}
+	r.seen()
+	r.watching = false
+	now = now.Add(time.Minute)
+	if !r.freshness().Equal(time.Unix(100, 0)) {
+		t.Errorf("expected the last event before the watch broke, got %v", r.freshness())
+	}
+}
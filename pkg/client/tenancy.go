@@ -0,0 +1,189 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeclient "k8s.io/client-go/kubernetes"
+)
+
+const (
+	// tenancyCacheTTL is how long the visible namespaces of a user are cached, new namespaces and
+	// role bindings show up after at most this long.
+	tenancyCacheTTL = time.Minute
+	// maxParallelReviews limits the concurrent SelfSubjectRulesReviews of a single user.
+	maxParallelReviews = 10
+)
+
+var (
+	namespaceTenancy  bool
+	visibleNamespaces = newTTLCache[*NamespaceVisibility](tenancyCacheTTL)
+	listVerbs         = map[string]bool{"list": true, "*": true}
+)
+
+// NamespaceVisibility describes the namespaces a user can see.
+type NamespaceVisibility struct {
+	// All is true if the user may list across all namespaces, or tenancy is disabled.
+	All bool
+	// Namespaces are the visible namespaces if All is false.
+	Namespaces []string
+}
+
+// NamespaceTenancyEnabled returns true if namespaced views are restricted to the namespaces of the user.
+func NamespaceTenancyEnabled() bool {
+	return namespaceTenancy
+}
+
+// GetVisibleNamespaces returns the namespaces of the karmada control plane the user of the request
+// can see. Users who may list everything cluster-wide see all namespaces, others the namespaces in
+// which a SelfSubjectRulesReview grants them to list at least one resource.
+func GetVisibleNamespaces(request *http.Request) (*NamespaceVisibility, error) {
+	return getVisibleNamespaces(request, "", func() (kubeclient.Interface, kubeclient.Interface, error) {
+		userClient, err := GetKubeClientFromRequest(request)
+		return userClient, InClusterClientForKarmadaAPIServer(), err
+	})
+}
+
+// GetVisibleMemberNamespaces returns the namespaces of the member cluster the user of the request can
+// see, reviewed like GetVisibleNamespaces through the cluster proxy.
+func GetVisibleMemberNamespaces(request *http.Request, clusterName string) (*NamespaceVisibility, error) {
+	return getVisibleNamespaces(request, clusterName, func() (kubeclient.Interface, kubeclient.Interface, error) {
+		userClient, err := GetMemberClientFromRequest(request, clusterName)
+		if err != nil {
+			return nil, nil, err
+		}
+		serviceClient := InClusterClientForMemberCluster(clusterName)
+		if serviceClient == nil {
+			return nil, nil, fmt.Errorf("could not init client for member cluster %s", clusterName)
+		}
+		return userClient, serviceClient, nil
+	})
+}
+
+// getVisibleNamespaces reviews the visible namespaces of the cluster with the user and service clients
+// of clients, clusterName is empty for the karmada control plane.
+func getVisibleNamespaces(request *http.Request, clusterName string,
+	clients func() (userClient, serviceClient kubeclient.Interface, err error)) (*NamespaceVisibility, error) {
+	if !namespaceTenancy {
+		return &NamespaceVisibility{All: true}, nil
+	}
+	key := serviceIdentityKey
+	if !useServiceIdentity(request) {
+		authInfo, err := buildAuthInfo(request)
+		if err != nil {
+			return nil, err
+		}
+		key = identityKey(authInfo)
+	}
+	key = clusterName + "/" + key
+	if visibility, ok := visibleNamespaces.get(key); ok {
+		return visibility, nil
+	}
+
+	userClient, serviceClient, err := clients()
+	if err != nil {
+		return nil, err
+	}
+	visibility, err := reviewVisibleNamespaces(request.Context(), userClient, serviceClient)
+	if err != nil {
+		return nil, err
+	}
+	visibleNamespaces.set(key, visibility)
+	return visibility, nil
+}
+
+// reviewVisibleNamespaces asks the apiserver what the user may see. The namespaces themselves are listed
+// with the dashboard's own identity, as tenants are usually not allowed to list namespaces.
+func reviewVisibleNamespaces(ctx context.Context, userClient, serviceClient kubeclient.Interface) (*NamespaceVisibility, error) {
+	review, err := userClient.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{Verb: "list", Group: "*", Resource: "*"},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if review.Status.Allowed {
+		return &NamespaceVisibility{All: true}, nil
+	}
+
+	namespaces, err := serviceClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		visible  = make([]string, 0)
+		limit    = make(chan struct{}, maxParallelReviews)
+	)
+	for i := range namespaces.Items {
+		namespace := namespaces.Items[i].Name
+		wg.Add(1)
+		limit <- struct{}{}
+		go func() {
+			defer func() {
+				<-limit
+				wg.Done()
+			}()
+			canList, err := canListInNamespace(ctx, userClient, namespace)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			if canList {
+				visible = append(visible, namespace)
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	sort.Strings(visible)
+	return &NamespaceVisibility{Namespaces: visible}, nil
+}
+
+func canListInNamespace(ctx context.Context, userClient kubeclient.Interface, namespace string) (bool, error) {
+	review, err := userClient.AuthorizationV1().SelfSubjectRulesReviews().Create(ctx, &authorizationv1.SelfSubjectRulesReview{
+		Spec: authorizationv1.SelfSubjectRulesReviewSpec{Namespace: namespace},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	for _, rule := range review.Status.ResourceRules {
+		for _, verb := range rule.Verbs {
+			if listVerbs[verb] {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
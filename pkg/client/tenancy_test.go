@@ -0,0 +1,89 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// tenantClient can list configmaps in the given namespace only.
+func tenantClient(namespace string) *fake.Clientset {
+	userClient := fake.NewSimpleClientset()
+	userClient.PrependReactor("create", "selfsubjectaccessreviews", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, &authorizationv1.SelfSubjectAccessReview{}, nil
+	})
+	userClient.PrependReactor("create", "selfsubjectrulesreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		review := action.(clienttesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectRulesReview)
+		if review.Spec.Namespace == namespace {
+			review.Status.ResourceRules = []authorizationv1.ResourceRule{{Verbs: []string{"list"}, Resources: []string{"configmaps"}}}
+		}
+		return true, review, nil
+	})
+	return userClient
+}
+
+func TestGetVisibleNamespaces(t *testing.T) {
+	namespaceTenancy, serviceIdentityFallback = true, true
+	t.Cleanup(func() {
+		namespaceTenancy, serviceIdentityFallback = false, false
+		visibleNamespaces = newTTLCache[*NamespaceVisibility](tenancyCacheTTL)
+	})
+	namespace := func(name string) *v1.Namespace {
+		return &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+	controlPlane := fake.NewSimpleClientset(namespace("team-a"), namespace("team-b"))
+	member := fake.NewSimpleClientset(namespace("team-a"), namespace("team-b"))
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/namespace", nil)
+
+	reviews := 0
+	visibility := func(clusterName string, userClient, serviceClient kubeclient.Interface) []string {
+		result, err := getVisibleNamespaces(request, clusterName, func() (kubeclient.Interface, kubeclient.Interface, error) {
+			reviews++
+			return userClient, serviceClient, nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return result.Namespaces
+	}
+
+	// the user has different permissions on the member cluster
+	if got := visibility("", tenantClient("team-a"), controlPlane); !reflect.DeepEqual(got, []string{"team-a"}) {
+		t.Errorf("expected team-a to be visible on the control plane, got %v", got)
+	}
+	if got := visibility("member1", tenantClient("team-b"), member); !reflect.DeepEqual(got, []string{"team-b"}) {
+		t.Errorf("expected team-b to be visible on the member cluster, got %v", got)
+	}
+	if reviews != 2 {
+		t.Errorf("expected the member cluster to be reviewed separately, got %d reviews", reviews)
+	}
+	visibility("member1", tenantClient("team-b"), member)
+	if reviews != 2 {
+		t.Errorf("expected the visibility of the member cluster to be cached")
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/gobuffalo/flect"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,20 +31,30 @@ import (
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
 )
 
 var (
-	kindToGroupVersionResource = map[string]schema.GroupVersionResource{}
+	kindToGroupVersionResource     = map[string]schema.GroupVersionResource{}
+	kindToGroupVersionResourceLock sync.RWMutex
+
+	// cachedDiscoveryClient is shared by all verbers. Discovery information does not depend on
+	// the identity of the caller, so there is no need to fetch it again for every user.
+	cachedDiscoveryClient     discovery.CachedDiscoveryInterface
+	cachedDiscoveryClientOnce sync.Once
+	cachedDiscoveryClientErr  error
 )
 
 // resourceVerber is a struct responsible for doing common verb operations on resources, like
 // DELETE, PUT, UPDATE.
 type resourceVerber struct {
 	client    dynamic.Interface
-	discovery discovery.DiscoveryInterface
+	discovery discovery.CachedDiscoveryInterface
 }
 
 func (v *resourceVerber) groupVersionResourceFromUnstructured(object *unstructured.Unstructured) schema.GroupVersionResource {
@@ -57,30 +68,49 @@ func (v *resourceVerber) groupVersionResourceFromUnstructured(object *unstructur
 }
 
 func (v *resourceVerber) groupVersionResourceFromKind(kind string) (schema.GroupVersionResource, error) {
-	if gvr, exists := kindToGroupVersionResource[kind]; exists {
+	if gvr, exists := lookupGroupVersionResource(kind); exists {
 		klog.V(3).InfoS("GroupVersionResource cache hit", "kind", kind)
 		return gvr, nil
 	}
 
 	klog.V(3).InfoS("GroupVersionResource cache miss", "kind", kind)
-	_, resourceList, err := v.discovery.ServerGroupsAndResources()
-	if err != nil {
+	if err := v.refreshGroupVersionResourceCache(); err != nil {
 		return schema.GroupVersionResource{}, err
 	}
+	if gvr, exists := lookupGroupVersionResource(kind); exists {
+		return gvr, nil
+	}
 
-	// Update cache
-	if err = v.buildGroupVersionResourceCache(resourceList); err != nil {
+	// The kind may belong to a CRD installed after discovery was cached, so invalidate and retry once.
+	v.discovery.Invalidate()
+	if err := v.refreshGroupVersionResourceCache(); err != nil {
 		return schema.GroupVersionResource{}, err
 	}
-
-	if gvr, exists := kindToGroupVersionResource[kind]; exists {
+	if gvr, exists := lookupGroupVersionResource(kind); exists {
 		return gvr, nil
 	}
 
 	return schema.GroupVersionResource{}, fmt.Errorf("could not find GVR for kind %s", kind)
 }
 
+func (v *resourceVerber) refreshGroupVersionResourceCache() error {
+	_, resourceList, err := v.discovery.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return err
+	}
+	return v.buildGroupVersionResourceCache(resourceList)
+}
+
+func lookupGroupVersionResource(kind string) (schema.GroupVersionResource, bool) {
+	kindToGroupVersionResourceLock.RLock()
+	defer kindToGroupVersionResourceLock.RUnlock()
+	gvr, exists := kindToGroupVersionResource[kind]
+	return gvr, exists
+}
+
 func (v *resourceVerber) buildGroupVersionResourceCache(resourceList []*metav1.APIResourceList) error {
+	kindToGroupVersionResourceLock.Lock()
+	defer kindToGroupVersionResourceLock.Unlock()
 	for _, resource := range resourceList {
 		gv, err := schema.ParseGroupVersion(resource.GroupVersion)
 		if err != nil {
@@ -129,7 +159,7 @@ func (v *resourceVerber) Delete(kind string, namespace string, name string, dele
 		defaultDeleteOptions.GracePeriodSeconds = &gracePeriodSeconds
 	}
 
-	return v.client.Resource(gvr).Namespace(namespace).Delete(context.TODO(), name, defaultDeleteOptions)
+	return handleVerberError(v.client.Resource(gvr).Namespace(namespace).Delete(context.TODO(), name, defaultDeleteOptions))
 }
 
 // Update patches resource of the given kind in the given namespace with the given name.
@@ -138,11 +168,11 @@ func (v *resourceVerber) Update(object *unstructured.Unstructured) error {
 	namespace := object.GetNamespace()
 	gvr := v.groupVersionResourceFromUnstructured(object)
 
-	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+	return handleVerberError(retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		klog.V(2).InfoS("fetching latest resource version", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource, "name", name, "namespace", namespace)
 		result, getErr := v.client.Resource(gvr).Namespace(namespace).Get(context.TODO(), name, metav1.GetOptions{})
 		if getErr != nil {
-			return fmt.Errorf("failed to get latest %s version: %v", gvr.Resource, getErr)
+			return fmt.Errorf("failed to get latest %s version: %w", gvr.Resource, getErr)
 		}
 
 		origData, err := result.MarshalJSON()
@@ -165,7 +195,7 @@ func (v *resourceVerber) Update(object *unstructured.Unstructured) error {
 		klog.V(3).InfoS("patching resource", "group", gvr.Group, "version", gvr.Version, "resource", gvr.Resource, "name", name, "namespace", namespace, "patch", string(patchBytes))
 		_, updateErr := v.client.Resource(gvr).Namespace(namespace).Patch(context.TODO(), name, k8stypes.MergePatchType, patchBytes, metav1.PatchOptions{})
 		return updateErr
-	})
+	}))
 }
 
 // Get gets the resource of the given kind in the given namespace with the given name.
@@ -174,7 +204,11 @@ func (v *resourceVerber) Get(kind string, namespace string, name string) (runtim
 	if err != nil {
 		return nil, err
 	}
-	return v.client.Resource(gvr).Namespace(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	result, err := v.client.Resource(gvr).Namespace(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, handleVerberError(err)
+	}
+	return result, nil
 }
 
 // Create creates the resource of the given kind in the given namespace with the given name.
@@ -182,17 +216,51 @@ func (v *resourceVerber) Create(object *unstructured.Unstructured) (*unstructure
 	namespace := object.GetNamespace()
 	gvr := v.groupVersionResourceFromUnstructured(object)
 
-	return v.client.Resource(gvr).Namespace(namespace).Create(context.TODO(), object, metav1.CreateOptions{})
+	result, err := v.client.Resource(gvr).Namespace(namespace).Create(context.TODO(), object, metav1.CreateOptions{})
+	if err != nil {
+		return nil, handleVerberError(err)
+	}
+	return result, nil
+}
+
+// handleVerberError converts a 403 returned by the API server into a forbidden error the
+// frontend can localize, other errors are returned unchanged.
+func handleVerberError(err error) error {
+	if err == nil || !errors.IsForbidden(err) {
+		return err
+	}
+	_, forbiddenErr := errors.HandleError(err)
+	return forbiddenErr
 }
 
-// VerberClient returns a resourceVerber client.
-func VerberClient(_ *http.Request) (ResourceVerber, error) {
-	// todo currently ignore rest.config from http.Request
-	restConfig, _, err := GetKarmadaConfig()
+func sharedCachedDiscoveryClient() (discovery.CachedDiscoveryInterface, error) {
+	cachedDiscoveryClientOnce.Do(func() {
+		restConfig, _, err := GetKarmadaConfig()
+		if err != nil {
+			cachedDiscoveryClientErr = err
+			return
+		}
+		discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+		if err != nil {
+			cachedDiscoveryClientErr = err
+			return
+		}
+		cachedDiscoveryClient = memory.NewMemCacheClient(discoveryClient)
+	})
+	return cachedDiscoveryClient, cachedDiscoveryClientErr
+}
+
+// VerberClient returns a resourceVerber client which acts with the bearer token and
+// impersonation headers of the given request.
+func VerberClient(request *http.Request) (ResourceVerber, error) {
+	if !isKarmadaInitialized() {
+		return nil, fmt.Errorf("client package not initialized")
+	}
+	restConfig, err := karmadaConfigFromRequest(request)
 	if err != nil {
 		return nil, err
 	}
-	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	discoveryClient, err := sharedCachedDiscoveryClient()
 	if err != nil {
 		return nil, err
 	}
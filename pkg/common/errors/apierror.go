@@ -0,0 +1,190 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// APIError is the structured error of API responses.
+type APIError struct {
+	// Code is a stable MSG_<VIEW>_<CAUSE_OF_ERROR>_ERROR code, clients localize the error by Code and Params.
+	Code string `json:"code"`
+	// Status is the HTTP status matching the error.
+	Status int `json:"status"`
+	// Reason is the StatusReason of Kubernetes errors, e.g. NotFound.
+	Reason metav1.StatusReason `json:"reason,omitempty"`
+	// Message is the message of the error, see TranslateMessage.
+	Message string `json:"message"`
+	// Params are the values for the localized message, e.g. the kind and name of a missing resource.
+	Params map[string]string `json:"params,omitempty"`
+	// Causes are the field-level causes of the error, e.g. of an invalid resource.
+	Causes []Cause `json:"causes,omitempty"`
+}
+
+// Cause is a field-level cause of an APIError.
+type Cause struct {
+	Type    metav1.CauseType `json:"type,omitempty"`
+	Field   string           `json:"field,omitempty"`
+	Message string           `json:"message"`
+}
+
+// codes are the codes set as error message by the dashboard itself, in the order they are looked for.
+var codes = []string{
+	MsgDeployNamespaceMismatchError,
+	MsgDeployEmptyNamespaceError,
+	MsgLoginUnauthorizedError,
+	MsgForbiddenError,
+	MsgDashboardExclusiveResourceError,
+	MsgTokenExpiredError,
+	MsgCSRFValidationError,
+}
+
+// reasonCodes are the codes of errors without a more specific code.
+var reasonCodes = map[metav1.StatusReason]string{
+	metav1.StatusReasonBadRequest:         MsgBadRequestError,
+	metav1.StatusReasonUnauthorized:       MsgLoginUnauthorizedError,
+	metav1.StatusReasonExpired:            MsgTokenExpiredError,
+	metav1.StatusReasonForbidden:          MsgForbiddenError,
+	metav1.StatusReasonNotFound:           MsgNotFoundError,
+	metav1.StatusReasonAlreadyExists:      MsgAlreadyExistsError,
+	metav1.StatusReasonConflict:           MsgConflictError,
+	metav1.StatusReasonInvalid:            MsgInvalidError,
+	metav1.StatusReasonTooManyRequests:    MsgTooManyRequestsError,
+	metav1.StatusReasonTimeout:            MsgTimeoutError,
+	metav1.StatusReasonServerTimeout:      MsgTimeoutError,
+	metav1.StatusReasonServiceUnavailable: MsgServiceUnavailableError,
+	metav1.StatusReasonInternalError:      MsgInternalError,
+}
+
+// reasonStatuses are the HTTP statuses of reasons, used when the error carries no valid status. Some
+// errors of the dashboard carry 500 for client errors, the reason wins over those.
+var reasonStatuses = map[metav1.StatusReason]int{
+	metav1.StatusReasonBadRequest:         http.StatusBadRequest,
+	metav1.StatusReasonUnauthorized:       http.StatusUnauthorized,
+	metav1.StatusReasonForbidden:          http.StatusForbidden,
+	metav1.StatusReasonNotFound:           http.StatusNotFound,
+	metav1.StatusReasonAlreadyExists:      http.StatusConflict,
+	metav1.StatusReasonConflict:           http.StatusConflict,
+	metav1.StatusReasonInvalid:            http.StatusUnprocessableEntity,
+	metav1.StatusReasonTooManyRequests:    http.StatusTooManyRequests,
+	metav1.StatusReasonTimeout:            http.StatusGatewayTimeout,
+	metav1.StatusReasonServerTimeout:      http.StatusGatewayTimeout,
+	metav1.StatusReasonServiceUnavailable: http.StatusServiceUnavailable,
+	metav1.StatusReasonMethodNotAllowed:   http.StatusMethodNotAllowed,
+	metav1.StatusReasonNotAcceptable:      http.StatusNotAcceptable,
+}
+
+// codeStatuses are the HTTP statuses of the errors recognized by partialsToErrors.
+var codeStatuses = map[string]int{
+	MsgDeployNamespaceMismatchError: http.StatusBadRequest,
+	MsgDeployEmptyNamespaceError:    http.StatusBadRequest,
+	MsgLoginUnauthorizedError:       http.StatusUnauthorized,
+}
+
+// NewAPIError converts err into the structured error of API responses. Kubernetes errors keep their
+// StatusReason and details, other errors are internal errors unless their message is recognized.
+func NewAPIError(err error) *APIError {
+	if err == nil {
+		return nil
+	}
+	apiError := &APIError{Message: err.Error(), Status: http.StatusInternalServerError}
+
+	var apiStatus k8serrors.APIStatus
+	if errors.As(err, &apiStatus) {
+		status := apiStatus.Status()
+		apiError.Reason = status.Reason
+		apiError.Status = httpStatus(status)
+		if details := status.Details; details != nil {
+			apiError.Params = detailsParams(details)
+			for _, cause := range details.Causes {
+				apiError.Causes = append(apiError.Causes, Cause{Type: cause.Type, Field: cause.Field, Message: cause.Message})
+			}
+		}
+	}
+
+	apiError.Code = errorCode(apiError.Message, apiError.Reason)
+	if apiError.Reason == "" {
+		if status, ok := codeStatuses[apiError.Code]; ok {
+			apiError.Status = status
+		}
+	}
+	if apiError.Code == "" {
+		apiError.Code = MsgInternalError
+		if apiError.Status < http.StatusInternalServerError {
+			apiError.Code = MsgBadRequestError
+		}
+	}
+	return apiError
+}
+
+// Localize translates the codes in the message for the Accept-Language header, see TranslateMessage.
+func (e *APIError) Localize(acceptLanguage string) *APIError {
+	e.Message = TranslateMessage(e.Message, acceptLanguage)
+	return e
+}
+
+func httpStatus(status metav1.Status) int {
+	code := int(status.Code)
+	reasonStatus, known := reasonStatuses[status.Reason]
+	switch {
+	case known && (code == http.StatusInternalServerError || code < http.StatusBadRequest || code > 599):
+		return reasonStatus
+	case code >= http.StatusBadRequest && code <= 599:
+		return code
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func errorCode(message string, reason metav1.StatusReason) string {
+	for _, code := range codes {
+		if strings.Contains(message, code) {
+			return code
+		}
+	}
+	for _, partialError := range partialsToErrors {
+		if strings.Contains(message, partialError.partial) {
+			return partialError.code
+		}
+	}
+	return reasonCodes[reason]
+}
+
+func detailsParams(details *metav1.StatusDetails) map[string]string {
+	params := make(map[string]string)
+	for key, value := range map[string]string{"name": details.Name, "group": details.Group, "kind": details.Kind} {
+		// errors like NewForbidden(MsgForbiddenError, err) carry the code as name
+		if value != "" && !slices.Contains(codes, value) {
+			params[key] = value
+		}
+	}
+	if details.RetryAfterSeconds > 0 {
+		params["retryAfterSeconds"] = strconv.Itoa(int(details.RetryAfterSeconds))
+	}
+	if len(params) == 0 {
+		return nil
+	}
+	return params
+}
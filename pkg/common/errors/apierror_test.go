@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors_test
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+func TestNewAPIError(t *testing.T) {
+	deployments := schema.GroupResource{Group: "apps", Resource: "deployments"}
+	cases := []struct {
+		err    error
+		code   string
+		status int
+		reason metav1.StatusReason
+	}{
+		{k8serrors.NewNotFound(deployments, "nginx"), errors.MsgNotFoundError, http.StatusNotFound, metav1.StatusReasonNotFound},
+		{fmt.Errorf("get: %w", k8serrors.NewConflict(deployments, "nginx", fmt.Errorf("changed"))), errors.MsgConflictError, http.StatusConflict, metav1.StatusReasonConflict},
+		{errors.NewInvalid("bad spec"), errors.MsgInvalidError, http.StatusUnprocessableEntity, metav1.StatusReasonInvalid},
+		{errors.NewInvalid("does not match the namespace"), errors.MsgDeployNamespaceMismatchError, http.StatusUnprocessableEntity, metav1.StatusReasonInvalid},
+		{errors.NewTokenExpired(errors.MsgTokenExpiredError), errors.MsgTokenExpiredError, http.StatusUnauthorized, metav1.StatusReasonExpired},
+		{errors.NewCSRFValidationError(), errors.MsgCSRFValidationError, http.StatusForbidden, metav1.StatusReasonForbidden},
+		{errors.NewInternal("boom"), errors.MsgInternalError, http.StatusInternalServerError, metav1.StatusReasonInternalError},
+		{fmt.Errorf("empty namespace may not be set"), errors.MsgDeployEmptyNamespaceError, http.StatusBadRequest, ""},
+		// the first matching partial wins
+		{fmt.Errorf("empty namespace may not be set, does not match the namespace"), errors.MsgDeployNamespaceMismatchError, http.StatusBadRequest, ""},
+		{fmt.Errorf("boom"), errors.MsgInternalError, http.StatusInternalServerError, ""},
+	}
+	for _, c := range cases {
+		apiError := errors.NewAPIError(c.err)
+		if apiError.Code != c.code || apiError.Status != c.status || apiError.Reason != c.reason {
+			t.Errorf("NewAPIError(%v) == %s/%d/%s, expected %s/%d/%s", c.err,
+				apiError.Code, apiError.Status, apiError.Reason, c.code, c.status, c.reason)
+		}
+	}
+	if errors.NewAPIError(nil) != nil {
+		t.Errorf("expected no error for nil")
+	}
+}
+
+func TestNewAPIErrorDetails(t *testing.T) {
+	err := k8serrors.NewInvalid(schema.GroupKind{Group: "apps", Kind: "Deployment"}, "nginx", field.ErrorList{
+		field.Required(field.NewPath("spec", "replicas"), ""),
+	})
+	apiError := errors.NewAPIError(err)
+	if !reflect.DeepEqual(apiError.Params, map[string]string{"name": "nginx", "group": "apps", "kind": "Deployment"}) {
+		t.Errorf("unexpected params %v", apiError.Params)
+	}
+	if len(apiError.Causes) != 1 || apiError.Causes[0].Field != "spec.replicas" || apiError.Causes[0].Type != metav1.CauseTypeFieldValueRequired {
+		t.Errorf("unexpected causes %+v", apiError.Causes)
+	}
+
+	forbidden := errors.NewAPIError(errors.NewForbidden(errors.MsgForbiddenError, fmt.Errorf("denied")))
+	if forbidden.Code != errors.MsgForbiddenError || forbidden.Params != nil {
+		t.Errorf("expected the forbidden code without params, got %+v", forbidden)
+	}
+}
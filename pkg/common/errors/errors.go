@@ -49,6 +49,18 @@ func NewTokenExpired(reason string) *k8serrors.StatusError {
 	}
 }
 
+// NewCSRFValidationError returns an error indicating that the csrf token of the request is missing or invalid.
+func NewCSRFValidationError() *k8serrors.StatusError {
+	return &k8serrors.StatusError{
+		ErrStatus: metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusForbidden,
+			Reason:  metav1.StatusReasonForbidden,
+			Message: MsgCSRFValidationError,
+		},
+	}
+}
+
 // NewBadRequest creates an error that indicates that the request is invalid and can not be processed.
 func NewBadRequest(reason string) *k8serrors.StatusError {
 	return k8serrors.NewBadRequest(reason)
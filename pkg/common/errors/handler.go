@@ -37,7 +37,7 @@ func HandleError(err error) (int, error) {
 		return http.StatusForbidden, NewForbidden(MsgForbiddenError, err)
 	}
 
-	return http.StatusInternalServerError, err
+	return NewAPIError(err).Status, err
 }
 
 // ExtractErrors handles single error, that occurred during API GET call. If it is not critical, then it will be
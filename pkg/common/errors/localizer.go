@@ -16,6 +16,8 @@ package errors
 
 import (
 	"strings"
+
+	"github.com/karmada-io/dashboard/pkg/i18n"
 )
 
 // Errors that can be used directly without localizing
@@ -27,21 +29,38 @@ const (
 	MsgDashboardExclusiveResourceError = "MSG_DASHBOARD_EXCLUSIVE_RESOURCE_ERROR"
 	MsgTokenExpiredError               = "MSG_TOKEN_EXPIRED_ERROR" //nolint:gosec // mistake `Token` as hardcoded credential
 	MsgCSRFValidationError             = "MSG_CSRF_VALIDATION_ERROR"
+
+	// codes of errors not recognized by a more specific code, derived from their StatusReason
+	MsgBadRequestError         = "MSG_BAD_REQUEST_ERROR"
+	MsgNotFoundError           = "MSG_NOT_FOUND_ERROR"
+	MsgAlreadyExistsError      = "MSG_ALREADY_EXISTS_ERROR"
+	MsgConflictError           = "MSG_CONFLICT_ERROR"
+	MsgInvalidError            = "MSG_INVALID_ERROR"
+	MsgTooManyRequestsError    = "MSG_TOO_MANY_REQUESTS_ERROR"
+	MsgTimeoutError            = "MSG_TIMEOUT_ERROR"
+	MsgServiceUnavailableError = "MSG_SERVICE_UNAVAILABLE_ERROR"
+	MsgInternalError           = "MSG_INTERNAL_ERROR"
 )
 
 // This file contains all errors that should be kept in sync with:
 // 'src/app/frontend/common/errors/errors.ts' and localized on frontend side.
 
-// partialsToErrorsMap map structure:
-// Key - unique partial string that can be used to differentiate error messages
-// Value - unique error code string that frontend can use to localize error message created using
-//
-//	pattern MSG_<VIEW>_<CAUSE_OF_ERROR>_ERROR
-//	<VIEW> - optional
-var partialsToErrorsMap = map[string]string{
-	"does not match the namespace":                               MsgDeployNamespaceMismatchError,
-	"empty namespace may not be set":                             MsgDeployEmptyNamespaceError,
-	"the server has asked for the client to provide credentials": MsgLoginUnauthorizedError,
+// partialError maps a partial error message to its error code.
+type partialError struct {
+	// partial is a unique partial string that can be used to differentiate error messages
+	partial string
+	// code is a unique error code string that frontend can use to localize error message created using
+	//
+	//	pattern MSG_<VIEW>_<CAUSE_OF_ERROR>_ERROR
+	//	<VIEW> - optional
+	code string
+}
+
+// partialsToErrors are matched in order, the first partial found in a message decides its code.
+var partialsToErrors = []partialError{
+	{partial: "does not match the namespace", code: MsgDeployNamespaceMismatchError},
+	{partial: "empty namespace may not be set", code: MsgDeployEmptyNamespaceError},
+	{partial: "the server has asked for the client to provide credentials", code: MsgLoginUnauthorizedError},
 }
 
 // LocalizeError returns error code (string) that can be used by frontend to localize error message.
@@ -50,15 +69,67 @@ func LocalizeError(err error) error {
 		return nil
 	}
 
-	for partial, errString := range partialsToErrorsMap {
-		if strings.Contains(err.Error(), partial) {
+	for _, partialError := range partialsToErrors {
+		if strings.Contains(err.Error(), partialError.partial) {
 			if IsUnauthorized(err) {
-				return NewUnauthorized(errString)
+				return NewUnauthorized(partialError.code)
 			}
 
-			return NewBadRequest(errString)
+			return NewBadRequest(partialError.code)
 		}
 	}
 
 	return err
 }
+
+// errorTranslations are the messages of the error codes for API clients asking for localized messages
+// with an Accept-Language header, the UI ships its own translations.
+var errorTranslations = map[string]map[string]string{
+	"en-US": {
+		MsgDeployNamespaceMismatchError:    "The namespace of the resource does not match the namespace of the request",
+		MsgDeployEmptyNamespaceError:       "The namespace of the resource must not be empty",
+		MsgLoginUnauthorizedError:          "The token is invalid or has expired, please log in again",
+		MsgForbiddenError:                  "You are not allowed to perform this action",
+		MsgDashboardExclusiveResourceError: "The resource is managed by the dashboard and can not be changed",
+		MsgTokenExpiredError:               "The token has expired, please log in again",
+		MsgCSRFValidationError:             "The csrf token is missing or invalid, please reload the page",
+	},
+	"zh-CN": {
+		MsgDeployNamespaceMismatchError:    "资源的命名空间与请求的命名空间不一致",
+		MsgDeployEmptyNamespaceError:       "资源的命名空间不能为空",
+		MsgLoginUnauthorizedError:          "令牌无效或已过期，请重新登录",
+		MsgForbiddenError:                  "您无权执行此操作",
+		MsgDashboardExclusiveResourceError: "该资源由 Dashboard 管理，无法修改",
+		MsgTokenExpiredError:               "令牌已过期，请重新登录",
+		MsgCSRFValidationError:             "CSRF 令牌缺失或无效，请刷新页面",
+	},
+}
+
+var errorTranslators = newErrorTranslators()
+
+func newErrorTranslators() map[string]*strings.Replacer {
+	translators := make(map[string]*strings.Replacer, len(errorTranslations))
+	for locale, translations := range errorTranslations {
+		oldnew := make([]string, 0, 2*len(translations))
+		for code, message := range translations {
+			oldnew = append(oldnew, code, message)
+		}
+		translators[locale] = strings.NewReplacer(oldnew...)
+	}
+	return translators
+}
+
+// TranslateMessage replaces the error codes in message by their translation to the locale best matching
+// the Accept-Language header, codes may be part of a longer message. Without a header the message is
+// returned unchanged.
+func TranslateMessage(message, acceptLanguage string) string {
+	preferred := i18n.ParseAcceptLanguage(acceptLanguage)
+	if len(preferred) == 0 || !strings.Contains(message, "MSG_") {
+		return message
+	}
+	locales := make([]string, 0, len(errorTranslators))
+	for locale := range errorTranslators {
+		locales = append(locales, locale)
+	}
+	return errorTranslators[i18n.Negotiate(preferred, locales, i18n.DefaultLocale)].Replace(message)
+}
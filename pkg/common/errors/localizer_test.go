@@ -15,6 +15,7 @@
 package errors_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/karmada-io/dashboard/pkg/common/errors"
@@ -50,6 +51,26 @@ func TestLocalizeError(t *testing.T) {
 	}
 }
 
+func TestTranslateMessage(t *testing.T) {
+	internal := errors.NewInternal(errors.MsgForbiddenError).Error()
+	cases := []struct {
+		message        string
+		acceptLanguage string
+		expected       string
+	}{
+		{errors.MsgCSRFValidationError, "", errors.MsgCSRFValidationError},
+		{errors.MsgCSRFValidationError, "fr", "The csrf token is missing or invalid, please reload the page"},
+		{errors.MsgTokenExpiredError, "zh-TW,en;q=0.5", "令牌已过期，请重新登录"},
+		{internal, "en-US", strings.Replace(internal, errors.MsgForbiddenError, "You are not allowed to perform this action", 1)},
+		{"some unknown error", "zh-CN", "some unknown error"},
+	}
+	for _, c := range cases {
+		if actual := errors.TranslateMessage(c.message, c.acceptLanguage); actual != c.expected {
+			t.Errorf("TranslateMessage(%q, %q) == %q, expected %q", c.message, c.acceptLanguage, actual, c.expected)
+		}
+	}
+}
+
 func areErrorsEqual(err1, err2 error) bool {
 	return (err1 != nil && err2 != nil && err1.Error() == err2.Error()) ||
 		(err1 == nil && err2 == nil)
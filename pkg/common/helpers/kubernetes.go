@@ -51,3 +51,9 @@ var ListEverything = metaV1.ListOptions{
 	LabelSelector: labels.Everything().String(),
 	FieldSelector: fields.Everything().String(),
 }
+
+// WatchFrom returns the list options to watch resources from resourceVersion, with bookmarks. An empty
+// resourceVersion starts with ADDED events of all existing resources.
+func WatchFrom(resourceVersion string) metaV1.ListOptions {
+	return metaV1.ListOptions{ResourceVersion: resourceVersion, AllowWatchBookmarks: true}
+}
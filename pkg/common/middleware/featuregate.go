@@ -0,0 +1,44 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/component-base/featuregate"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/config"
+)
+
+// FailFunc writes the error response of a request, the binaries answer errors in their own format.
+type FailFunc func(c *gin.Context, err error)
+
+// FeatureGate hides the route while the feature is disabled in the dashboard config, fail answers the
+// request with a not found error then. The gate is checked per request, so routes follow config
+// changes without a restart.
+func FeatureGate(feature featuregate.Feature, fail FailFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !config.FeatureEnabled(feature) {
+			c.Abort()
+			fail(c, errors.NewNotFound(fmt.Sprintf("feature %s is disabled", feature)))
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/config"
+	"github.com/karmada-io/dashboard/pkg/features"
+)
+
+func TestFeatureGate(t *testing.T) {
+	mountPath := filepath.Join(t.TempDir(), "prod.yaml")
+	if err := os.WriteFile(mountPath, []byte("feature_gates:\n  PreciseScheduling: false\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.InitDashboardConfigFromMountFile(mountPath); err != nil {
+		t.Fatal(err)
+	}
+
+	fail := func(c *gin.Context, err error) {
+		c.String(errors.NewAPIError(err).Status, err.Error())
+	}
+	engine := gin.New()
+	engine.GET("/disabled", FeatureGate(features.PreciseScheduling, fail), func(c *gin.Context) { c.Status(http.StatusOK) })
+	engine.GET("/enabled", FeatureGate(features.MetricsScraper, fail), func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	for path, status := range map[string]int{"/disabled": http.StatusNotFound, "/enabled": http.StatusOK} {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		if w.Code != status {
+			t.Errorf("expected status %d for %s, got %d: %s", status, path, w.Code, w.Body.String())
+		}
+	}
+}
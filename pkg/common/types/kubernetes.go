@@ -80,6 +80,10 @@ type TypeMeta struct {
 type ListMeta struct {
 	// Total number of items on the list. Used for pagination.
 	TotalItems int `json:"totalItems"`
+	// Continue is the token of the next page in cursor pagination, empty on the last page.
+	Continue string `json:"continue,omitempty"`
+	// RemainingItemCount estimates the number of items after this page in cursor pagination.
+	RemainingItemCount *int64 `json:"remainingItemCount,omitempty"`
 }
 
 // NewObjectMeta returns internal endpoint name for the given service properties, e.g.,
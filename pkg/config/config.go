@@ -17,34 +17,61 @@ limitations under the License.
 package config
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/karmada-io/karmada/pkg/util/fedinformer"
 	"gopkg.in/yaml.v3"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 )
 
-var dashboardConfig DashboardConfig
-
 const (
-	configName      = "karmada-dashboard-configmap"
-	configNamespace = "karmada-system"
-	defaultEnvName  = "prod"
+	// DefaultConfigName is the default name of the dashboard ConfigMap.
+	DefaultConfigName = "karmada-dashboard-configmap"
+	defaultEnvName    = "prod"
 )
 
+// ConfigMapRef locates the dashboard ConfigMap on the host cluster. Registry credentials are kept in
+// Secrets in the same namespace.
+type ConfigMapRef struct {
+	Namespace string
+	Name      string
+}
+
+// configMapRef is the ConfigMap watched by InitDashboardConfig.
+var configMapRef = ConfigMapRef{Namespace: "karmada-system", Name: DefaultConfigName}
+
+// GetConfigMapRef returns the ConfigMap the dashboard configuration is read from.
+func GetConfigMapRef() ConfigMapRef {
+	return configMapRef
+}
+
+func (ref ConfigMapRef) String() string {
+	return fmt.Sprintf("configmap %s/%s", ref.Namespace, ref.Name)
+}
+
+var errSourceDeleted = fmt.Errorf("the config source was deleted, using the default config")
+
 var (
 	configmapGVR = schema.GroupVersionResource{
 		Group:    "",
 		Version:  "v1",
 		Resource: "configmaps",
 	}
+	// configGroupKind names the config in validation errors.
+	configGroupKind = schema.GroupKind{Group: "dashboard.karmada.io", Kind: "DashboardConfig"}
 )
 
 // GetConfigKey returns the configuration key based on the environment name.
@@ -56,40 +83,38 @@ func GetConfigKey() string {
 	return fmt.Sprintf("%s.yaml", envName)
 }
 
-// InitDashboardConfig initializes the dashboard configuration using a Kubernetes client.
-func InitDashboardConfig(k8sClient kubernetes.Interface, stopper <-chan struct{}) {
-	factory := informers.NewSharedInformerFactory(k8sClient, 0)
+// InitDashboardConfig initializes the dashboard configuration from the ConfigMap ref using a Kubernetes client.
+func InitDashboardConfig(k8sClient kubernetes.Interface, ref ConfigMapRef, stopper <-chan struct{}) {
+	configMapRef = ref
+	factory := informers.NewSharedInformerFactoryWithOptions(k8sClient, 0, informers.WithNamespace(ref.Namespace))
 	resource, err := factory.ForResource(configmapGVR)
 	if err != nil {
 		klog.Fatalf("Failed to create resource: %v", err)
 		panic(err)
 	}
 	filterFunc := func(obj interface{}) bool {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
 		configMap, ok := obj.(*v1.ConfigMap)
-		return ok && configMap.Namespace == configNamespace && configMap.Name == configName
+		return ok && configMap.Namespace == ref.Namespace && configMap.Name == ref.Name
 	}
 	onAdd := func(obj interface{}) {
 		configMap := obj.(*v1.ConfigMap)
 		klog.Infof("ConfigMap %s Added", configMap.Name)
 		klog.Infof("ConfigMap Data is \n%+v", configMap.Data[GetConfigKey()])
-		var tmpConfig DashboardConfig
-		if err := yaml.Unmarshal([]byte(configMap.Data[GetConfigKey()]), &tmpConfig); err != nil {
-			klog.Errorf("Failed to unmarshal ConfigMap %s: %v", configMap.Name, err)
-		} else {
-			dashboardConfig = tmpConfig
-		}
+		loadConfigMap(configMap, ref)
 	}
 	onUpdate := func(_, newObj interface{}) {
 		newConfigMap := newObj.(*v1.ConfigMap)
 		klog.V(2).Infof("ConfigMap %s Updated", newConfigMap.Name)
-		var tmpConfig DashboardConfig
-		if err := yaml.Unmarshal([]byte(newConfigMap.Data[GetConfigKey()]), &tmpConfig); err != nil {
-			klog.Errorf("Failed to unmarshal ConfigMap %s: %v", newConfigMap.Name, err)
-		} else {
-			dashboardConfig = tmpConfig
-		}
+		loadConfigMap(newConfigMap, ref)
+	}
+	onDelete := func(_ interface{}) {
+		klog.Warningf("ConfigMap %s Deleted, falling back to the default config", ref.Name)
+		configHolder.storeDeleted(ref.String())
 	}
-	evtHandler := fedinformer.NewFilteringHandlerOnAllEvents(filterFunc, onAdd, onUpdate, nil)
+	evtHandler := fedinformer.NewFilteringHandlerOnAllEvents(filterFunc, onAdd, onUpdate, onDelete)
 	_, err = resource.Informer().AddEventHandler(evtHandler)
 	if err != nil {
 		klog.Errorf("Failed to add handler for resource(%s): %v", configmapGVR.String(), err)
@@ -102,20 +127,44 @@ func InitDashboardConfig(k8sClient kubernetes.Interface, stopper <-chan struct{}
 
 // GetDashboardConfig returns a copy of the current dashboard configuration.
 func GetDashboardConfig() DashboardConfig {
-	return DashboardConfig{
-		DockerRegistries: dashboardConfig.DockerRegistries,
-		ChartRegistries:  dashboardConfig.ChartRegistries,
-		MenuConfigs:      dashboardConfig.MenuConfigs,
-		PathPrefix:       dashboardConfig.PathPrefix,
-	}
+	return configHolder.get()
+}
+
+// UpdateOptions are the preconditions and metadata of a config update.
+type UpdateOptions struct {
+	// ResourceVersion, if set, must match the ConfigMap, otherwise the update is rejected with a conflict.
+	ResourceVersion string
+	// User is recorded in the history as the author of the new revision.
+	User string
 }
 
-// UpdateDashboardConfig updates the dashboard configuration in the Kubernetes ConfigMap.
-func UpdateDashboardConfig(k8sClient kubernetes.Interface, newDashboardConfig DashboardConfig) error {
+// UpdateDashboardConfig validates the new config and stores it in the Kubernetes ConfigMap ref as the
+// next revision. Registry passwords are moved into Secrets, see ApplyRegistryCredentials. The replaced
+// revision is kept in the history once the update succeeded.
+func UpdateDashboardConfig(k8sClient kubernetes.Interface, ref ConfigMapRef, newDashboardConfig DashboardConfig, opts UpdateOptions) error {
+	if errs := ValidateDashboardConfig(newDashboardConfig); len(errs) > 0 {
+		return NewValidationError(ref.Name, errs)
+	}
+	newDashboardConfig.APIVersion = ConfigAPIVersion
+
 	ctx := context.TODO()
-	oldConfigMap, err := k8sClient.CoreV1().ConfigMaps(configNamespace).Get(ctx, configName, metav1.GetOptions{})
+	oldConfigMap, err := k8sClient.CoreV1().ConfigMaps(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		klog.Errorf("Failed to get ConfigMap %s/%s: %v", ref.Namespace, ref.Name, err)
+		return err
+	}
+	if opts.ResourceVersion != "" && opts.ResourceVersion != oldConfigMap.ResourceVersion {
+		return apierrors.NewConflict(configmapGVR.GroupResource(), ref.Name,
+			fmt.Errorf("the config was changed in the meantime, resource version %s is outdated", opts.ResourceVersion))
+	}
+	oldDashboardConfig, err := configFromConfigMap(oldConfigMap)
 	if err != nil {
-		klog.Errorf("Failed to get ConfigMap %s: %v", configName, err)
+		// a broken config keeps no secrets worth reusing
+		oldDashboardConfig = GetDashboardConfig()
+	}
+	newDashboardConfig, err = ApplyRegistryCredentials(k8sClient, ref.Namespace, oldDashboardConfig, newDashboardConfig)
+	if err != nil {
+		klog.Errorf("Failed to store registry credentials: %v", err)
 		return err
 	}
 	configKey := GetConfigKey()
@@ -124,15 +173,53 @@ func UpdateDashboardConfig(k8sClient kubernetes.Interface, newDashboardConfig Da
 		klog.Errorf("Failed to marshal new dashboard config: %v", err)
 		return err
 	}
+
+	replaced := oldConfigMap.DeepCopy()
+	if oldConfigMap.Data == nil {
+		oldConfigMap.Data = make(map[string]string)
+	}
 	oldConfigMap.Data[configKey] = string(buff)
-	_, err = k8sClient.CoreV1().ConfigMaps(configNamespace).Update(ctx, oldConfigMap, metav1.UpdateOptions{})
+	if oldConfigMap.Annotations == nil {
+		oldConfigMap.Annotations = make(map[string]string)
+	}
+	oldConfigMap.Annotations[revisionAnnotation] = strconv.FormatInt(revisionOf(oldConfigMap)+1, 10)
+	oldConfigMap.Annotations[updatedByAnnotation] = opts.User
+	oldConfigMap.Annotations[updatedAtAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	_, err = k8sClient.CoreV1().ConfigMaps(ref.Namespace).Update(ctx, oldConfigMap, metav1.UpdateOptions{})
 	if err != nil {
-		klog.Errorf("Failed to update ConfigMap %s: %v", configName, err)
+		klog.Errorf("Failed to update ConfigMap %s/%s: %v", ref.Namespace, ref.Name, err)
 		return err
 	}
+	// the new config is stored, a missing history entry only limits the rollbacks
+	if err := archiveRevision(ctx, k8sClient, ref, replaced); err != nil {
+		klog.Errorf("Failed to archive revision of ConfigMap %s/%s: %v", ref.Namespace, ref.Name, err)
+	}
 	return nil
 }
 
+// loadConfigMap hands the config of the ConfigMap to the dashboard, the last good config stays in use
+// if it can not be parsed.
+func loadConfigMap(configMap *v1.ConfigMap, ref ConfigMapRef) {
+	tmpConfig, err := configFromConfigMap(configMap)
+	if err != nil {
+		klog.Errorf("Failed to unmarshal ConfigMap %s: %v", configMap.Name, err)
+		configHolder.storeError(err, ref.String())
+		return
+	}
+	configHolder.store(tmpConfig, ref.String())
+}
+
+// configFromConfigMap reads the config of the current environment from the ConfigMap.
+func configFromConfigMap(configMap *v1.ConfigMap) (DashboardConfig, error) {
+	var tmpConfig DashboardConfig
+	if err := yaml.Unmarshal([]byte(configMap.Data[GetConfigKey()]), &tmpConfig); err != nil {
+		return tmpConfig, err
+	}
+	tmpConfig.ResourceVersion = configMap.ResourceVersion
+	tmpConfig.Revision = revisionOf(configMap)
+	return tmpConfig, nil
+}
+
 // InitDashboardConfigFromMountFile initializes the dashboard configuration from a mounted file.
 func InitDashboardConfigFromMountFile(mountPath string) error {
 	_, err := os.Stat(mountPath)
@@ -147,8 +234,38 @@ func InitDashboardConfigFromMountFile(mountPath string) error {
 	var tmpConfig DashboardConfig
 	if err = yaml.Unmarshal(content, &tmpConfig); err != nil {
 		klog.Errorf("Failed to unmarshal from content %v", err)
+		configHolder.storeError(err, mountPath)
 		return err
 	}
-	dashboardConfig = tmpConfig
+	configHolder.store(tmpConfig, mountPath)
 	return nil
 }
+
+// WatchDashboardConfigFile reloads the mounted config file when its content changes, e.g. after the
+// kubelet updated a mounted ConfigMap. A removed file falls back to the default config.
+func WatchDashboardConfigFile(mountPath string, interval time.Duration, stopCh <-chan struct{}) {
+	lastContent, _ := os.ReadFile(mountPath)
+	go wait.Until(func() {
+		content, err := os.ReadFile(mountPath)
+		switch {
+		case os.IsNotExist(err):
+			if lastContent != nil {
+				klog.Warningf("Config file %s removed, falling back to the default config", mountPath)
+				configHolder.storeDeleted(mountPath)
+				lastContent = nil
+			}
+		case err != nil:
+			klog.Errorf("Failed to read config file %s: %v", mountPath, err)
+		case !bytes.Equal(content, lastContent):
+			lastContent = content
+			var tmpConfig DashboardConfig
+			if err := yaml.Unmarshal(content, &tmpConfig); err != nil {
+				klog.Errorf("Failed to unmarshal config file %s: %v", mountPath, err)
+				configHolder.storeError(err, mountPath)
+				return
+			}
+			klog.Infof("Config file %s changed, reloaded", mountPath)
+			configHolder.store(tmpConfig, mountPath)
+		}
+	}, interval, stopCh)
+}
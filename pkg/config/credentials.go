@@ -0,0 +1,202 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+const (
+	registryKindDocker = "docker"
+	registryKindChart  = "chart"
+
+	// registryCredentialsLabel marks the Secrets holding registry credentials, its value is the registry kind.
+	registryCredentialsLabel = "dashboard.karmada.io/registry-credentials"
+)
+
+// registryEntry points into a registry of a DashboardConfig, docker and chart registries are handled alike.
+type registryEntry struct {
+	kind        string
+	name        string
+	credentials *RegistryCredentials
+}
+
+func registryEntries(dashboardConfig *DashboardConfig) []registryEntry {
+	entries := make([]registryEntry, 0, len(dashboardConfig.DockerRegistries)+len(dashboardConfig.ChartRegistries))
+	for i := range dashboardConfig.DockerRegistries {
+		registry := &dashboardConfig.DockerRegistries[i]
+		entries = append(entries, registryEntry{kind: registryKindDocker, name: registry.Name, credentials: &registry.RegistryCredentials})
+	}
+	for i := range dashboardConfig.ChartRegistries {
+		registry := &dashboardConfig.ChartRegistries[i]
+		entries = append(entries, registryEntry{kind: registryKindChart, name: registry.Name, credentials: &registry.RegistryCredentials})
+	}
+	return entries
+}
+
+// copyRegistries gives the config its own registry slices, so that credentials can be changed
+// without touching the shared current config.
+func copyRegistries(dashboardConfig DashboardConfig) DashboardConfig {
+	dashboardConfig.DockerRegistries = slices.Clone(dashboardConfig.DockerRegistries)
+	dashboardConfig.ChartRegistries = slices.Clone(dashboardConfig.ChartRegistries)
+	return dashboardConfig
+}
+
+// registryCredentialsSecretName derives a valid Secret name from the possibly arbitrary registry name.
+func registryCredentialsSecretName(kind, name string) string {
+	sum := sha256.Sum256([]byte(name))
+	return fmt.Sprintf("karmada-dashboard-%s-registry-%s", kind, hex.EncodeToString(sum[:])[:10])
+}
+
+// RedactRegistryCredentials returns a copy of the config without passwords, HasPassword tells whether
+// a registry has one stored.
+func RedactRegistryCredentials(dashboardConfig DashboardConfig) DashboardConfig {
+	dashboardConfig = copyRegistries(dashboardConfig)
+	for _, entry := range registryEntries(&dashboardConfig) {
+		entry.credentials.HasPassword = entry.credentials.SecretRef != "" || entry.credentials.Password != ""
+		entry.credentials.Password = ""
+	}
+	return dashboardConfig
+}
+
+// ApplyRegistryCredentials moves the passwords of newConfig into Secrets in namespace and returns the
+// config to store. Registries without a new password keep the Secret of the registry with the same name in
+// oldConfig, Secrets of removed registries are deleted.
+func ApplyRegistryCredentials(k8sClient kubernetes.Interface, namespace string, oldConfig, newConfig DashboardConfig) (DashboardConfig, error) {
+	ctx := context.TODO()
+	newConfig = copyRegistries(newConfig)
+	oldRefs := make(map[string]string)
+	for _, entry := range registryEntries(&oldConfig) {
+		if entry.credentials.SecretRef != "" {
+			oldRefs[entry.kind+"/"+entry.name] = entry.credentials.SecretRef
+		}
+	}
+
+	keep := make(map[string]bool)
+	for _, entry := range registryEntries(&newConfig) {
+		credentials := entry.credentials
+		credentials.HasPassword = false
+		if credentials.Password != "" {
+			secretName, err := storeRegistryCredentials(ctx, k8sClient, namespace, entry, credentials.User, credentials.Password)
+			if err != nil {
+				return newConfig, err
+			}
+			credentials.SecretRef = secretName
+			credentials.Password = ""
+		} else {
+			// the ref of the old entry wins, clients can not point a registry at an arbitrary Secret
+			credentials.SecretRef = oldRefs[entry.kind+"/"+entry.name]
+		}
+		if credentials.SecretRef != "" {
+			keep[credentials.SecretRef] = true
+		}
+	}
+
+	for _, secretName := range oldRefs {
+		if keep[secretName] {
+			continue
+		}
+		err := k8sClient.CoreV1().Secrets(namespace).Delete(ctx, secretName, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete registry credentials", "secret", secretName)
+		}
+	}
+	return newConfig, nil
+}
+
+func storeRegistryCredentials(ctx context.Context, k8sClient kubernetes.Interface, namespace string, entry registryEntry, user, password string) (string, error) {
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        registryCredentialsSecretName(entry.kind, entry.name),
+			Namespace:   namespace,
+			Labels:      map[string]string{registryCredentialsLabel: entry.kind},
+			Annotations: map[string]string{"dashboard.karmada.io/registry-name": entry.name},
+		},
+		Type: v1.SecretTypeBasicAuth,
+		Data: map[string][]byte{
+			v1.BasicAuthUsernameKey: []byte(user),
+			v1.BasicAuthPasswordKey: []byte(password),
+		},
+	}
+	secrets := k8sClient.CoreV1().Secrets(namespace)
+	existing, err := secrets.Get(ctx, secret.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		_, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+	case err == nil:
+		existing.Labels = secret.Labels
+		existing.Annotations = secret.Annotations
+		existing.Data = secret.Data
+		_, err = secrets.Update(ctx, existing, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		klog.ErrorS(err, "Failed to store registry credentials", "kind", entry.kind, "registry", entry.name)
+		return "", err
+	}
+	return secret.Name, nil
+}
+
+// MigrateRegistryCredentials moves plaintext registry passwords found in the dashboard ConfigMap ref into
+// Secrets. It runs on startup, a missing ConfigMap is not an error.
+func MigrateRegistryCredentials(k8sClient kubernetes.Interface, ref ConfigMapRef) error {
+	ctx := context.TODO()
+	configMap, err := k8sClient.CoreV1().ConfigMaps(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	configKey := GetConfigKey()
+	var storedConfig DashboardConfig
+	if err := yaml.Unmarshal([]byte(configMap.Data[configKey]), &storedConfig); err != nil {
+		return fmt.Errorf("failed to unmarshal ConfigMap %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+
+	plaintext := 0
+	for _, entry := range registryEntries(&storedConfig) {
+		if entry.credentials.Password != "" {
+			plaintext++
+		}
+	}
+	if plaintext == 0 {
+		return nil
+	}
+	klog.InfoS("Migrating plaintext registry credentials to Secrets", "count", plaintext)
+	migratedConfig, err := ApplyRegistryCredentials(k8sClient, ref.Namespace, storedConfig, storedConfig)
+	if err != nil {
+		return err
+	}
+	buff, err := yaml.Marshal(migratedConfig)
+	if err != nil {
+		return err
+	}
+	configMap.Data[configKey] = string(buff)
+	_, err = k8sClient.CoreV1().ConfigMaps(ref.Namespace).Update(ctx, configMap, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var testRef = ConfigMapRef{Namespace: "dashboard-a", Name: "dashboard-config"}
+
+func TestMigrateRegistryCredentials(t *testing.T) {
+	plaintext := DashboardConfig{
+		DockerRegistries: []DockerRegistry{{Name: "hub", URL: "docker.io", RegistryCredentials: RegistryCredentials{User: "alice", Password: "s3cret"}}},
+	}
+	buff, err := yaml.Marshal(plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k8sClient := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: testRef.Name, Namespace: testRef.Namespace},
+		Data:       map[string]string{GetConfigKey(): string(buff)},
+	})
+
+	if err := MigrateRegistryCredentials(k8sClient, testRef); err != nil {
+		t.Fatal(err)
+	}
+	configMap, err := k8sClient.CoreV1().ConfigMaps(testRef.Namespace).Get(context.TODO(), testRef.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(configMap.Data[GetConfigKey()], "s3cret") {
+		t.Fatalf("expected the password to be removed from the ConfigMap:\n%s", configMap.Data[GetConfigKey()])
+	}
+	var migrated DashboardConfig
+	if err := yaml.Unmarshal([]byte(configMap.Data[GetConfigKey()]), &migrated); err != nil {
+		t.Fatal(err)
+	}
+	secret, err := k8sClient.CoreV1().Secrets(testRef.Namespace).Get(context.TODO(), migrated.DockerRegistries[0].SecretRef, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, password := string(secret.Data[v1.BasicAuthUsernameKey]), string(secret.Data[v1.BasicAuthPasswordKey])
+	if user != "alice" || password != "s3cret" {
+		t.Errorf("unexpected credentials %q/%q", user, password)
+	}
+}
+
+func TestApplyRegistryCredentials(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset()
+	stored, err := ApplyRegistryCredentials(k8sClient, testRef.Namespace, DashboardConfig{}, DashboardConfig{
+		ChartRegistries: []ChartRegistry{{Name: "charts", RegistryCredentials: RegistryCredentials{User: "bob", Password: "pw"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	secretRef := stored.ChartRegistries[0].SecretRef
+	if secretRef == "" || stored.ChartRegistries[0].Password != "" {
+		t.Fatalf("expected the password to be replaced by a secret ref, got %+v", stored.ChartRegistries[0])
+	}
+
+	redacted := RedactRegistryCredentials(stored)
+	if !redacted.ChartRegistries[0].HasPassword {
+		t.Errorf("expected the redacted config to report the stored password")
+	}
+
+	// resubmitting without a password keeps the secret, a foreign ref is ignored
+	resubmitted := DashboardConfig{
+		ChartRegistries: []ChartRegistry{{Name: "charts", RegistryCredentials: RegistryCredentials{User: "bob", SecretRef: "other"}}},
+	}
+	kept, err := ApplyRegistryCredentials(k8sClient, testRef.Namespace, stored, resubmitted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kept.ChartRegistries[0].SecretRef != secretRef {
+		t.Errorf("expected secret ref %s, got %s", secretRef, kept.ChartRegistries[0].SecretRef)
+	}
+
+	// removing the registry removes its secret
+	if _, err := ApplyRegistryCredentials(k8sClient, testRef.Namespace, kept, DashboardConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := k8sClient.CoreV1().Secrets(testRef.Namespace).Get(context.TODO(), secretRef, metav1.GetOptions{}); err == nil {
+		t.Errorf("expected secret %s to be deleted", secretRef)
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+const (
+	revisionAnnotation  = "dashboard.karmada.io/config-revision"
+	updatedByAnnotation = "dashboard.karmada.io/config-updated-by"
+	updatedAtAnnotation = "dashboard.karmada.io/config-updated-at"
+
+	// maxHistory is the number of replaced revisions kept for rollback.
+	maxHistory = 10
+	// historyKeySuffix ends the keys of the revisions in the history ConfigMap.
+	historyKeySuffix = ".yaml"
+)
+
+// ConfigRevision is a previous revision of the dashboard config. Registry passwords are not part of
+// the history, a rollback keeps the current password of registries with the same name.
+type ConfigRevision struct {
+	Revision  int64           `yaml:"revision" json:"revision"`
+	UpdatedBy string          `yaml:"updated_by" json:"updated_by,omitempty"`
+	UpdatedAt string          `yaml:"updated_at" json:"updated_at,omitempty"`
+	Config    DashboardConfig `yaml:"config" json:"config"`
+}
+
+// historyConfigMapName is the ConfigMap next to ref holding the replaced revisions.
+func historyConfigMapName(ref ConfigMapRef) string {
+	return ref.Name + "-history"
+}
+
+// revisionOf returns the revision of the config in the ConfigMap, configs written before revisions
+// were counted are revision 1.
+func revisionOf(configMap *v1.ConfigMap) int64 {
+	revision, err := strconv.ParseInt(configMap.Annotations[revisionAnnotation], 10, 64)
+	if err != nil || revision < 1 {
+		return 1
+	}
+	return revision
+}
+
+// archiveRevision adds the config currently stored in the ConfigMap to the history and drops the
+// oldest revisions beyond maxHistory.
+func archiveRevision(ctx context.Context, k8sClient kubernetes.Interface, ref ConfigMapRef, configMap *v1.ConfigMap) error {
+	content, ok := configMap.Data[GetConfigKey()]
+	if !ok || content == "" {
+		return nil
+	}
+	var current DashboardConfig
+	if err := yaml.Unmarshal([]byte(content), &current); err != nil {
+		// a broken config is not worth a rollback target
+		return nil
+	}
+	revision := ConfigRevision{
+		Revision:  revisionOf(configMap),
+		UpdatedBy: configMap.Annotations[updatedByAnnotation],
+		UpdatedAt: configMap.Annotations[updatedAtAnnotation],
+		Config:    current,
+	}
+	buff, err := yaml.Marshal(revision)
+	if err != nil {
+		return err
+	}
+
+	configMaps := k8sClient.CoreV1().ConfigMaps(ref.Namespace)
+	history, err := configMaps.Get(ctx, historyConfigMapName(ref), metav1.GetOptions{})
+	exists := err == nil
+	if apierrors.IsNotFound(err) {
+		history = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: historyConfigMapName(ref), Namespace: ref.Namespace},
+		}
+	} else if err != nil {
+		return err
+	}
+	if history.Data == nil {
+		history.Data = make(map[string]string)
+	}
+	history.Data[revisionKey(revision.Revision)] = string(buff)
+
+	revisions := historyRevisions(history)
+	for len(revisions) > maxHistory {
+		delete(history.Data, revisionKey(revisions[len(revisions)-1]))
+		revisions = revisions[:len(revisions)-1]
+	}
+
+	if !exists {
+		_, err = configMaps.Create(ctx, history, metav1.CreateOptions{})
+	} else {
+		_, err = configMaps.Update(ctx, history, metav1.UpdateOptions{})
+	}
+	return err
+}
+
+func revisionKey(revision int64) string {
+	return fmt.Sprintf("%d%s", revision, historyKeySuffix)
+}
+
+// historyRevisions returns the revisions in the history, newest first.
+func historyRevisions(history *v1.ConfigMap) []int64 {
+	revisions := make([]int64, 0, len(history.Data))
+	for key := range history.Data {
+		revision, err := strconv.ParseInt(strings.TrimSuffix(key, historyKeySuffix), 10, 64)
+		if err == nil {
+			revisions = append(revisions, revision)
+		}
+	}
+	sort.Slice(revisions, func(i, j int) bool { return revisions[i] > revisions[j] })
+	return revisions
+}
+
+// ListConfigHistory returns the previous revisions of the config, newest first.
+func ListConfigHistory(k8sClient kubernetes.Interface, ref ConfigMapRef) ([]ConfigRevision, error) {
+	history, err := k8sClient.CoreV1().ConfigMaps(ref.Namespace).Get(context.TODO(), historyConfigMapName(ref), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return []ConfigRevision{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := make([]ConfigRevision, 0, len(history.Data))
+	for _, revision := range historyRevisions(history) {
+		var configRevision ConfigRevision
+		if err := yaml.Unmarshal([]byte(history.Data[revisionKey(revision)]), &configRevision); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal revision %d: %w", revision, err)
+		}
+		result = append(result, configRevision)
+	}
+	return result, nil
+}
+
+// GetConfigRevision returns a previous revision of the config.
+func GetConfigRevision(k8sClient kubernetes.Interface, ref ConfigMapRef, revision int64) (*ConfigRevision, error) {
+	revisions, err := ListConfigHistory(k8sClient, ref)
+	if err != nil {
+		return nil, err
+	}
+	for i := range revisions {
+		if revisions[i].Revision == revision {
+			return &revisions[i], nil
+		}
+	}
+	return nil, errors.NewNotFound(fmt.Sprintf("revision %d of the dashboard config is not in the history", revision))
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestUpdateDashboardConfigHistory(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: testRef.Name, Namespace: testRef.Namespace, ResourceVersion: "1"},
+		Data:       map[string]string{GetConfigKey(): "path_prefix: /initial\n"},
+	})
+
+	for i := 0; i < maxHistory+2; i++ {
+		newConfig := DashboardConfig{PathPrefix: fmt.Sprintf("/rev-%d", i+2)}
+		if err := UpdateDashboardConfig(k8sClient, testRef, newConfig, UpdateOptions{User: "alice"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	configMap, err := k8sClient.CoreV1().ConfigMaps(testRef.Namespace).Get(context.TODO(), testRef.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := configFromConfigMap(configMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.Revision != maxHistory+3 || current.APIVersion != ConfigAPIVersion {
+		t.Errorf("unexpected current config %+v", current)
+	}
+
+	history, err := ListConfigHistory(k8sClient, testRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != maxHistory {
+		t.Fatalf("expected %d revisions, got %d", maxHistory, len(history))
+	}
+	if history[0].Revision != maxHistory+2 || history[0].UpdatedBy != "alice" {
+		t.Errorf("expected the newest revision first, got %+v", history[0])
+	}
+	if _, err := GetConfigRevision(k8sClient, testRef, 1); err == nil {
+		t.Errorf("expected the initial revision to be pruned")
+	}
+	revision, err := GetConfigRevision(k8sClient, testRef, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revision.Config.PathPrefix != "/rev-5" {
+		t.Errorf("unexpected config of revision 5: %+v", revision.Config)
+	}
+}
+
+func TestUpdateDashboardConfigPreconditions(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: testRef.Name, Namespace: testRef.Namespace, ResourceVersion: "7"},
+		Data:       map[string]string{},
+	})
+
+	err := UpdateDashboardConfig(k8sClient, testRef, DashboardConfig{}, UpdateOptions{ResourceVersion: "6"})
+	if !apierrors.IsConflict(err) {
+		t.Errorf("expected a conflict, got %v", err)
+	}
+	err = UpdateDashboardConfig(k8sClient, testRef, DashboardConfig{PathPrefix: "relative"}, UpdateOptions{})
+	if !apierrors.IsInvalid(err) {
+		t.Errorf("expected the config to be invalid, got %v", err)
+	}
+	if err := UpdateDashboardConfig(k8sClient, testRef, DashboardConfig{}, UpdateOptions{ResourceVersion: "7"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestUpdateDashboardConfigFailureKeepsHistory(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: testRef.Name, Namespace: testRef.Namespace, ResourceVersion: "1"},
+		Data:       map[string]string{GetConfigKey(): "path_prefix: /initial\n"},
+	})
+	k8sClient.PrependReactor("update", "configmaps", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewInternalError(fmt.Errorf("etcd is gone"))
+	})
+
+	if err := UpdateDashboardConfig(k8sClient, testRef, DashboardConfig{PathPrefix: "/new"}, UpdateOptions{}); err == nil {
+		t.Fatal("expected the update to fail")
+	}
+	history, err := ListConfigHistory(k8sClient, testRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 0 {
+		t.Errorf("expected no revision to be archived for a failed update, got %+v", history)
+	}
+}
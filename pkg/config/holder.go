@@ -0,0 +1,171 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/component-base/featuregate"
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/dashboard/pkg/features"
+)
+
+// Status describes the last loads of the dashboard config.
+type Status struct {
+	// Source is the ConfigMap or file the config is read from.
+	Source string `json:"source"`
+	// Generation is incremented with every config handed to the dashboard, 0 means none was loaded yet.
+	Generation      int64  `json:"generation"`
+	ResourceVersion string `json:"resource_version,omitempty"`
+	Revision        int64  `json:"revision,omitempty"`
+	// LastLoadTime is the time of the last successful load.
+	LastLoadTime *time.Time `json:"last_load_time,omitempty"`
+	// LastError is the last parse error or the deletion of the source, the last good config stays in
+	// use on parse errors.
+	LastError     string     `json:"last_error,omitempty"`
+	LastErrorTime *time.Time `json:"last_error_time,omitempty"`
+}
+
+// Subscriber is called with every new config.
+type Subscriber func(dashboardConfig DashboardConfig)
+
+// snapshot is a loaded config, it is never modified once stored.
+type snapshot struct {
+	config      DashboardConfig
+	featureGate featuregate.FeatureGate
+	generation  int64
+}
+
+// holder keeps the current config for concurrent readers. Loads are serialized and subscribers are
+// notified in the order of the loads.
+type holder struct {
+	current atomic.Pointer[snapshot]
+
+	lock        sync.Mutex
+	status      Status
+	subscribers map[int]Subscriber
+	nextID      int
+}
+
+var configHolder = newHolder()
+
+func newHolder() *holder {
+	h := &holder{subscribers: make(map[int]Subscriber)}
+	h.current.Store(&snapshot{featureGate: defaultFeatureGate()})
+	return h
+}
+
+func defaultFeatureGate() featuregate.FeatureGate {
+	gate, err := features.NewFeatureGate(nil)
+	if err != nil {
+		// the defaults are fixed at compile time
+		panic(err)
+	}
+	return gate
+}
+
+func (h *holder) get() DashboardConfig {
+	return h.current.Load().config.DeepCopy()
+}
+
+func (h *holder) store(dashboardConfig DashboardConfig, source string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	now := time.Now()
+	h.status.Source = source
+	h.status.Generation++
+	h.status.ResourceVersion = dashboardConfig.ResourceVersion
+	h.status.Revision = dashboardConfig.Revision
+	h.status.LastLoadTime = &now
+	gate, err := features.NewFeatureGate(dashboardConfig.FeatureGates)
+	if err != nil {
+		// configs are validated before they are stored, but the ConfigMap may be edited by hand
+		klog.ErrorS(err, "Invalid feature gates in the dashboard config, using the defaults", "source", source)
+		h.status.LastError = fmt.Sprintf("invalid feature gates: %v", err)
+		h.status.LastErrorTime = &now
+		gate = defaultFeatureGate()
+	}
+	h.current.Store(&snapshot{config: dashboardConfig, featureGate: gate, generation: h.status.Generation})
+	for _, subscriber := range h.subscribers {
+		subscriber(dashboardConfig.DeepCopy())
+	}
+}
+
+func (h *holder) storeError(err error, source string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	now := time.Now()
+	h.status.Source = source
+	h.status.LastError = err.Error()
+	h.status.LastErrorTime = &now
+}
+
+// storeDeleted falls back to the empty config when the source is gone.
+func (h *holder) storeDeleted(source string) {
+	h.storeError(errSourceDeleted, source)
+	h.store(DashboardConfig{}, source)
+}
+
+func (h *holder) featureEnabled(feature featuregate.Feature) bool {
+	return h.current.Load().featureGate.Enabled(feature)
+}
+
+func (h *holder) subscribe(subscriber Subscriber) func() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	id := h.nextID
+	h.nextID++
+	h.subscribers[id] = subscriber
+	return func() {
+		h.lock.Lock()
+		defer h.lock.Unlock()
+		delete(h.subscribers, id)
+	}
+}
+
+func (h *holder) getStatus() Status {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return h.status
+}
+
+// Subscribe registers a subscriber called with every config loaded from now on, including the empty
+// config used after the source was deleted. Subscribers are called one after another and must not
+// block. The returned func removes the subscriber.
+func Subscribe(subscriber Subscriber) func() {
+	return configHolder.subscribe(subscriber)
+}
+
+// GetStatus returns the status of the dashboard config loads.
+func GetStatus() Status {
+	return configHolder.getStatus()
+}
+
+// FeatureEnabled returns whether the dashboard feature is enabled by the current config.
+func FeatureEnabled(feature featuregate.Feature) bool {
+	return configHolder.featureEnabled(feature)
+}
+
+// EffectiveFeatureGates returns whether each dashboard feature is enabled by the current config,
+// including the features left at their default.
+func EffectiveFeatureGates() map[string]bool {
+	return features.Effective(configHolder.current.Load().featureGate)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/karmada-io/dashboard/pkg/features"
+)
+
+func TestHolder(t *testing.T) {
+	h := newHolder()
+	var seen []string
+	unsubscribe := h.subscribe(func(dashboardConfig DashboardConfig) {
+		seen = append(seen, dashboardConfig.PathPrefix)
+	})
+
+	h.store(DashboardConfig{PathPrefix: "/a", MenuConfigs: []MenuConfig{{Path: "/overview"}}}, "test")
+	got := h.get()
+	got.MenuConfigs[0].Path = "/changed"
+	if h.get().MenuConfigs[0].Path != "/overview" {
+		t.Errorf("expected readers to get a copy of the config")
+	}
+
+	h.storeError(errors.New("broken"), "test")
+	if h.get().PathPrefix != "/a" {
+		t.Errorf("expected the last good config to stay in use after a parse error")
+	}
+	status := h.getStatus()
+	if status.Generation != 1 || status.LastError != "broken" || status.LastLoadTime == nil {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	h.storeDeleted("test")
+	if h.get().PathPrefix != "" || h.getStatus().Generation != 2 {
+		t.Errorf("expected the default config after the source was deleted")
+	}
+
+	unsubscribe()
+	h.store(DashboardConfig{PathPrefix: "/b"}, "test")
+	if fmt.Sprint(seen) != "[/a ]" {
+		t.Errorf("unexpected notifications %v", seen)
+	}
+}
+
+func TestHolderConcurrentReaders(t *testing.T) {
+	h := newHolder()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				h.store(DashboardConfig{PathPrefix: fmt.Sprintf("/%d", j)}, "test")
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = h.get().PathPrefix
+			}
+		}()
+	}
+	wg.Wait()
+	if h.getStatus().Generation != 400 {
+		t.Errorf("expected 400 generations, got %d", h.getStatus().Generation)
+	}
+}
+
+func TestHolderFeatureGates(t *testing.T) {
+	h := newHolder()
+	if !h.featureEnabled(features.PreciseScheduling) {
+		t.Errorf("expected the default gates before a config was loaded")
+	}
+
+	h.store(DashboardConfig{FeatureGates: map[string]bool{string(features.PreciseScheduling): false}}, "test")
+	if h.featureEnabled(features.PreciseScheduling) || !h.featureEnabled(features.MetricsScraper) {
+		t.Errorf("expected only PreciseScheduling to be disabled")
+	}
+
+	h.store(DashboardConfig{FeatureGates: map[string]bool{"Unknown": true}}, "test")
+	if !h.featureEnabled(features.PreciseScheduling) || h.getStatus().LastError == "" {
+		t.Errorf("expected the default gates and an error for unknown features, got status %+v", h.getStatus())
+	}
+}
@@ -16,22 +16,41 @@ limitations under the License.
 
 package config
 
+import (
+	"maps"
+	"slices"
+)
+
+// ConfigAPIVersion is the version of the DashboardConfig schema written by this dashboard.
+const ConfigAPIVersion = "v1"
+
+// RegistryCredentials are the credentials of a registry. The password is kept in a Secret next to
+// the dashboard ConfigMap and never returned by the config API.
+type RegistryCredentials struct {
+	User string `yaml:"user" json:"user"`
+	// Password is write-only, a non-empty password replaces the stored one. It is only found in the
+	// ConfigMap for entries written before the credentials moved to Secrets, see MigrateRegistryCredentials.
+	Password string `yaml:"password,omitempty" json:"password,omitempty"`
+	// SecretRef is the name of the kubernetes.io/basic-auth Secret holding the credentials.
+	SecretRef string `yaml:"secret_ref,omitempty" json:"secret_ref,omitempty"`
+	// HasPassword tells API clients whether a password is stored.
+	HasPassword bool `yaml:"-" json:"has_password"`
+}
+
 // DockerRegistry represents a Docker registry configuration.
 type DockerRegistry struct {
-	Name     string `yaml:"name" json:"name"`
-	URL      string `yaml:"url" json:"url"`
-	User     string `yaml:"user" json:"user"`
-	Password string `yaml:"password" json:"password"`
-	AddTime  int64  `yaml:"add_time" json:"add_time"`
+	Name                string `yaml:"name" json:"name"`
+	URL                 string `yaml:"url" json:"url"`
+	RegistryCredentials `yaml:",inline" json:",inline"`
+	AddTime             int64 `yaml:"add_time" json:"add_time"`
 }
 
 // ChartRegistry represents a Helm chart registry configuration.
 type ChartRegistry struct {
-	Name     string `yaml:"name" json:"name"`
-	URL      string `yaml:"url" json:"url"`
-	User     string `yaml:"user" json:"user"`
-	Password string `yaml:"password" json:"password"`
-	AddTime  int64  `yaml:"add_time" json:"add_time"`
+	Name                string `yaml:"name" json:"name"`
+	URL                 string `yaml:"url" json:"url"`
+	RegistryCredentials `yaml:",inline" json:",inline"`
+	AddTime             int64 `yaml:"add_time" json:"add_time"`
 }
 
 // MenuConfig represents a menu configuration.
@@ -42,10 +61,147 @@ type MenuConfig struct {
 	Children   []MenuConfig `yaml:"children" json:"children,omitempty"`
 }
 
+// RoleRule grants verbs on dashboard API resources. Resources are the first path segment of the
+// API routes below /api/v1, e.g. cluster or propagationpolicy, and "*" matches all of them.
+// Verbs are read and write.
+type RoleRule struct {
+	Verbs     []string `yaml:"verbs" json:"verbs"`
+	Resources []string `yaml:"resources" json:"resources"`
+}
+
+// Role is a named set of dashboard permissions. Roles with the name of a built-in role
+// (viewer, operator, admin) replace it.
+type Role struct {
+	Name  string     `yaml:"name" json:"name"`
+	Rules []RoleRule `yaml:"rules" json:"rules"`
+	// Menus are the sidebar keys of the menu entries visible to the role, "*" shows all.
+	Menus []string `yaml:"menus" json:"menus"`
+}
+
+// RoleBinding grants a role to users and groups of the authenticated identity.
+type RoleBinding struct {
+	Role   string   `yaml:"role" json:"role"`
+	Users  []string `yaml:"users" json:"users,omitempty"`
+	Groups []string `yaml:"groups" json:"groups,omitempty"`
+}
+
+// DefaultProductName is shown when the branding sets no product name.
+const DefaultProductName = "Karmada Dashboard"
+
+// BrandingAsset is a file of a ConfigMap or Secret served by karmada-dashboard-web, e.g. a logo. The
+// ConfigMap must be mounted at <branding-dir>/configmaps/<name> and the Secret at
+// <branding-dir>/secrets/<name> of the web container.
+type BrandingAsset struct {
+	ConfigMap string `yaml:"config_map,omitempty" json:"config_map,omitempty"`
+	Secret    string `yaml:"secret,omitempty" json:"secret,omitempty"`
+	Key       string `yaml:"key" json:"key"`
+}
+
+// BrandingTheme overrides colours of the UI theme, colours are given as #rgb or #rrggbb.
+type BrandingTheme struct {
+	PrimaryColor          string `yaml:"primary_color,omitempty" json:"primary_color,omitempty"`
+	HeaderBackgroundColor string `yaml:"header_background_color,omitempty" json:"header_background_color,omitempty"`
+}
+
+// FooterLink is a link shown in the footer of the UI.
+type FooterLink struct {
+	Text string `yaml:"text" json:"text"`
+	URL  string `yaml:"url" json:"url"`
+}
+
+// Branding customizes the look of the dashboard for OEM deployments.
+type Branding struct {
+	ProductName string         `yaml:"product_name,omitempty" json:"product_name,omitempty"`
+	Logo        *BrandingAsset `yaml:"logo,omitempty" json:"logo,omitempty"`
+	Favicon     *BrandingAsset `yaml:"favicon,omitempty" json:"favicon,omitempty"`
+	Theme       BrandingTheme  `yaml:"theme,omitempty" json:"theme,omitempty"`
+	// LoginBanner is a plain text notice shown on the login page.
+	LoginBanner string       `yaml:"login_banner,omitempty" json:"login_banner,omitempty"`
+	FooterLinks []FooterLink `yaml:"footer_links,omitempty" json:"footer_links,omitempty"`
+}
+
 // DashboardConfig represents the configuration structure for the Karmada dashboard.
 type DashboardConfig struct {
+	// APIVersion is the schema version, configs without one are read as ConfigAPIVersion.
+	APIVersion       string           `yaml:"api_version,omitempty" json:"api_version,omitempty"`
 	DockerRegistries []DockerRegistry `yaml:"docker_registries" json:"docker_registries"`
 	ChartRegistries  []ChartRegistry  `yaml:"chart_registries" json:"chart_registries"`
 	MenuConfigs      []MenuConfig     `yaml:"menu_configs" json:"menu_configs"`
 	PathPrefix       string           `yaml:"path_prefix" json:"path_prefix"`
+	// Roles defines custom roles in addition to the built-in ones.
+	Roles []Role `yaml:"roles" json:"roles,omitempty"`
+	// RoleBindings enable dashboard RBAC, without bindings every authenticated user has full access to
+	// the resources, but nobody may administer the dashboard itself, e.g. read the audit log.
+	RoleBindings []RoleBinding `yaml:"role_bindings" json:"role_bindings,omitempty"`
+	// DefaultRole is granted to users without a binding, empty denies them access.
+	DefaultRole string `yaml:"default_role" json:"default_role,omitempty"`
+	// FeatureGates overrides the defaults of the dashboard features, see the features package.
+	FeatureGates map[string]bool `yaml:"feature_gates,omitempty" json:"feature_gates,omitempty"`
+	// Branding replaces the product name, logos and colours of the UI.
+	Branding *Branding `yaml:"branding,omitempty" json:"branding,omitempty"`
+
+	// ResourceVersion of the ConfigMap the config was read from, updates based on an older
+	// resource version are rejected.
+	ResourceVersion string `yaml:"-" json:"resource_version,omitempty"`
+	// Revision counts the updates made through the dashboard, see ListConfigHistory.
+	Revision int64 `yaml:"-" json:"revision,omitempty"`
+}
+
+// DeepCopy returns a copy of the config which shares no slices or maps with c.
+func (c DashboardConfig) DeepCopy() DashboardConfig {
+	c.DockerRegistries = slices.Clone(c.DockerRegistries)
+	c.ChartRegistries = slices.Clone(c.ChartRegistries)
+	c.MenuConfigs = copyMenus(c.MenuConfigs)
+	if c.Roles != nil {
+		roles := make([]Role, len(c.Roles))
+		for i, role := range c.Roles {
+			role.Menus = slices.Clone(role.Menus)
+			rules := make([]RoleRule, len(role.Rules))
+			for j, rule := range role.Rules {
+				rules[j] = RoleRule{
+					Verbs:     slices.Clone(rule.Verbs),
+					Resources: slices.Clone(rule.Resources),
+				}
+			}
+			role.Rules = rules
+			roles[i] = role
+		}
+		c.Roles = roles
+	}
+	if c.RoleBindings != nil {
+		bindings := make([]RoleBinding, len(c.RoleBindings))
+		for i, binding := range c.RoleBindings {
+			binding.Users = slices.Clone(binding.Users)
+			binding.Groups = slices.Clone(binding.Groups)
+			bindings[i] = binding
+		}
+		c.RoleBindings = bindings
+	}
+	c.FeatureGates = maps.Clone(c.FeatureGates)
+	if c.Branding != nil {
+		branding := *c.Branding
+		if branding.Logo != nil {
+			logo := *branding.Logo
+			branding.Logo = &logo
+		}
+		if branding.Favicon != nil {
+			favicon := *branding.Favicon
+			branding.Favicon = &favicon
+		}
+		branding.FooterLinks = slices.Clone(branding.FooterLinks)
+		c.Branding = &branding
+	}
+	return c
+}
+
+func copyMenus(menus []MenuConfig) []MenuConfig {
+	if menus == nil {
+		return nil
+	}
+	copied := make([]MenuConfig, len(menus))
+	for i, menu := range menus {
+		menu.Children = copyMenus(menu.Children)
+		copied[i] = menu
+	}
+	return copied
 }
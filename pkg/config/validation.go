@@ -0,0 +1,298 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/karmada-io/dashboard/pkg/features"
+)
+
+// BuiltinRoleNames are the roles predefined by the dashboard RBAC, role bindings may refer to them
+// without defining them.
+var BuiltinRoleNames = []string{"viewer", "operator", "admin"}
+
+var roleVerbs = map[string]bool{"read": true, "write": true, "*": true}
+
+var colorRegexp = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+const (
+	maxProductNameLength = 64
+	maxLoginBannerLength = 2048
+)
+
+// NewValidationError wraps the errors of ValidateDashboardConfig for the config named name.
+func NewValidationError(name string, errs field.ErrorList) error {
+	return apierrors.NewInvalid(configGroupKind, name, errs)
+}
+
+// ValidateDashboardConfig checks the config before it is stored.
+func ValidateDashboardConfig(dashboardConfig DashboardConfig) field.ErrorList {
+	var allErrs field.ErrorList
+	if dashboardConfig.APIVersion != "" && dashboardConfig.APIVersion != ConfigAPIVersion {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("api_version"), dashboardConfig.APIVersion, []string{ConfigAPIVersion}))
+	}
+	if dashboardConfig.PathPrefix != "" && !strings.HasPrefix(dashboardConfig.PathPrefix, "/") {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("path_prefix"), dashboardConfig.PathPrefix, "must start with /"))
+	}
+
+	dockerPath := field.NewPath("docker_registries")
+	dockerNames := make(map[string]bool)
+	for i, registry := range dashboardConfig.DockerRegistries {
+		allErrs = append(allErrs, validateRegistry(dockerPath.Index(i), registry.Name, registry.URL, dockerNames)...)
+	}
+	chartPath := field.NewPath("chart_registries")
+	chartNames := make(map[string]bool)
+	for i, registry := range dashboardConfig.ChartRegistries {
+		allErrs = append(allErrs, validateRegistry(chartPath.Index(i), registry.Name, registry.URL, chartNames)...)
+	}
+
+	allErrs = append(allErrs, validateMenus(field.NewPath("menu_configs"), dashboardConfig.MenuConfigs, true, make(map[string]bool))...)
+	allErrs = append(allErrs, validateRoles(dashboardConfig)...)
+	allErrs = append(allErrs, validateFeatureGates(field.NewPath("feature_gates"), dashboardConfig.FeatureGates)...)
+	if dashboardConfig.Branding != nil {
+		allErrs = append(allErrs, validateBranding(field.NewPath("branding"), *dashboardConfig.Branding)...)
+	}
+	return allErrs
+}
+
+func validateFeatureGates(path *field.Path, featureGates map[string]bool) field.ErrorList {
+	var allErrs field.ErrorList
+	known := features.KnownFeatures()
+	names := make([]string, 0, len(featureGates))
+	for name := range featureGates {
+		names = append(names, name)
+	}
+	// sorted for stable error messages
+	sort.Strings(names)
+	for _, name := range names {
+		if !slices.Contains(known, name) {
+			allErrs = append(allErrs, field.NotSupported(path.Key(name), name, known))
+		}
+	}
+	if len(allErrs) == 0 {
+		if _, err := features.NewFeatureGate(featureGates); err != nil {
+			allErrs = append(allErrs, field.Invalid(path, featureGates, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+func validateRegistry(path *field.Path, name, registryURL string, names map[string]bool) field.ErrorList {
+	var allErrs field.ErrorList
+	switch {
+	case name == "":
+		allErrs = append(allErrs, field.Required(path.Child("name"), ""))
+	case names[name]:
+		allErrs = append(allErrs, field.Duplicate(path.Child("name"), name))
+	}
+	names[name] = true
+
+	if registryURL == "" {
+		return append(allErrs, field.Required(path.Child("url"), ""))
+	}
+	// registries are often given as a bare host, e.g. docker.io
+	toParse := registryURL
+	if !strings.Contains(toParse, "://") {
+		toParse = "https://" + toParse
+	}
+	parsed, err := url.Parse(toParse)
+	switch {
+	case err != nil:
+		allErrs = append(allErrs, field.Invalid(path.Child("url"), registryURL, err.Error()))
+	case parsed.Scheme != "http" && parsed.Scheme != "https" && parsed.Scheme != "oci":
+		allErrs = append(allErrs, field.Invalid(path.Child("url"), registryURL, "scheme must be http, https or oci"))
+	case parsed.Host == "":
+		allErrs = append(allErrs, field.Invalid(path.Child("url"), registryURL, "must contain a host"))
+	}
+	return allErrs
+}
+
+// validateMenus checks the menu tree: top level paths are absolute, children are relative to their
+// parent, paths are unique among siblings and sidebar keys are unique in the whole tree.
+func validateMenus(path *field.Path, menus []MenuConfig, topLevel bool, sidebarKeys map[string]bool) field.ErrorList {
+	var allErrs field.ErrorList
+	paths := make(map[string]bool)
+	for i, menu := range menus {
+		menuPath := path.Index(i)
+		switch {
+		case menu.Path == "":
+			allErrs = append(allErrs, field.Required(menuPath.Child("path"), ""))
+		case topLevel && !strings.HasPrefix(menu.Path, "/"):
+			allErrs = append(allErrs, field.Invalid(menuPath.Child("path"), menu.Path, "top level menus must start with /"))
+		case !topLevel && strings.HasPrefix(menu.Path, "/"):
+			allErrs = append(allErrs, field.Invalid(menuPath.Child("path"), menu.Path, "child menus must be relative to their parent"))
+		case paths[menu.Path]:
+			allErrs = append(allErrs, field.Duplicate(menuPath.Child("path"), menu.Path))
+		}
+		paths[menu.Path] = true
+
+		switch {
+		case menu.SidebarKey == "":
+			allErrs = append(allErrs, field.Required(menuPath.Child("sidebar_key"), ""))
+		case sidebarKeys[menu.SidebarKey]:
+			allErrs = append(allErrs, field.Duplicate(menuPath.Child("sidebar_key"), menu.SidebarKey))
+		}
+		sidebarKeys[menu.SidebarKey] = true
+
+		allErrs = append(allErrs, validateMenus(menuPath.Child("children"), menu.Children, false, sidebarKeys)...)
+	}
+	return allErrs
+}
+
+func validateRoles(dashboardConfig DashboardConfig) field.ErrorList {
+	var allErrs field.ErrorList
+	roleNames := make(map[string]bool)
+	for _, name := range BuiltinRoleNames {
+		roleNames[name] = true
+	}
+
+	rolesPath := field.NewPath("roles")
+	customRoles := make(map[string]bool)
+	for i, role := range dashboardConfig.Roles {
+		rolePath := rolesPath.Index(i)
+		switch {
+		case role.Name == "":
+			allErrs = append(allErrs, field.Required(rolePath.Child("name"), ""))
+		case customRoles[role.Name]:
+			allErrs = append(allErrs, field.Duplicate(rolePath.Child("name"), role.Name))
+		}
+		customRoles[role.Name] = true
+		roleNames[role.Name] = true
+		for j, rule := range role.Rules {
+			for k, verb := range rule.Verbs {
+				if !roleVerbs[verb] {
+					allErrs = append(allErrs, field.NotSupported(rolePath.Child("rules").Index(j).Child("verbs").Index(k), verb, []string{"read", "write", "*"}))
+				}
+			}
+		}
+	}
+
+	bindingsPath := field.NewPath("role_bindings")
+	for i, binding := range dashboardConfig.RoleBindings {
+		if !roleNames[binding.Role] {
+			allErrs = append(allErrs, field.NotFound(bindingsPath.Index(i).Child("role"), binding.Role))
+		}
+		if len(binding.Users) == 0 && len(binding.Groups) == 0 {
+			allErrs = append(allErrs, field.Required(bindingsPath.Index(i), "at least one user or group"))
+		}
+	}
+	if dashboardConfig.DefaultRole != "" && !roleNames[dashboardConfig.DefaultRole] {
+		allErrs = append(allErrs, field.NotFound(field.NewPath("default_role"), dashboardConfig.DefaultRole))
+	}
+	return allErrs
+}
+
+func validateBranding(path *field.Path, branding Branding) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(branding.ProductName) > maxProductNameLength {
+		allErrs = append(allErrs, field.TooLong(path.Child("product_name"), branding.ProductName, maxProductNameLength))
+	}
+	if len(branding.LoginBanner) > maxLoginBannerLength {
+		allErrs = append(allErrs, field.TooLong(path.Child("login_banner"), "", maxLoginBannerLength))
+	}
+	if branding.Logo != nil {
+		allErrs = append(allErrs, validateBrandingAsset(path.Child("logo"), *branding.Logo)...)
+	}
+	if branding.Favicon != nil {
+		allErrs = append(allErrs, validateBrandingAsset(path.Child("favicon"), *branding.Favicon)...)
+	}
+
+	themePath := path.Child("theme")
+	for _, color := range []struct{ name, value string }{
+		{"primary_color", branding.Theme.PrimaryColor},
+		{"header_background_color", branding.Theme.HeaderBackgroundColor},
+	} {
+		if color.value != "" && !colorRegexp.MatchString(color.value) {
+			allErrs = append(allErrs, field.Invalid(themePath.Child(color.name), color.value, "must be a colour like #326ce5"))
+		}
+	}
+
+	for i, link := range branding.FooterLinks {
+		linkPath := path.Child("footer_links").Index(i)
+		if link.Text == "" {
+			allErrs = append(allErrs, field.Required(linkPath.Child("text"), ""))
+		}
+		if link.URL == "" {
+			allErrs = append(allErrs, field.Required(linkPath.Child("url"), ""))
+			continue
+		}
+		// links are rendered into the UI, javascript: and similar schemes are rejected
+		if !isWebLink(link.URL) {
+			allErrs = append(allErrs, field.Invalid(linkPath.Child("url"), link.URL, "must be an absolute path or a http or https URL"))
+		}
+	}
+	return allErrs
+}
+
+func isWebLink(link string) bool {
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+	if parsed.Scheme == "" && parsed.Host == "" {
+		return strings.HasPrefix(link, "/")
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// Path returns the file of the asset below dir, the branding dir of karmada-dashboard-web. Configs read from
+// a mounted file are not validated on write, so the asset is checked before it is turned into a path.
+func (asset BrandingAsset) Path(dir string) (string, error) {
+	if errs := validateBrandingAsset(field.NewPath("asset"), asset); len(errs) > 0 {
+		return "", errs.ToAggregate()
+	}
+	if asset.ConfigMap != "" {
+		return filepath.Join(dir, "configmaps", asset.ConfigMap, asset.Key), nil
+	}
+	return filepath.Join(dir, "secrets", asset.Secret, asset.Key), nil
+}
+
+func validateBrandingAsset(path *field.Path, asset BrandingAsset) field.ErrorList {
+	var allErrs field.ErrorList
+	switch {
+	case asset.ConfigMap == "" && asset.Secret == "":
+		allErrs = append(allErrs, field.Required(path, "one of config_map or secret"))
+	case asset.ConfigMap != "" && asset.Secret != "":
+		allErrs = append(allErrs, field.Forbidden(path.Child("secret"), "may not be set together with config_map"))
+	case asset.ConfigMap != "":
+		for _, msg := range validation.IsDNS1123Subdomain(asset.ConfigMap) {
+			allErrs = append(allErrs, field.Invalid(path.Child("config_map"), asset.ConfigMap, msg))
+		}
+	default:
+		for _, msg := range validation.IsDNS1123Subdomain(asset.Secret) {
+			allErrs = append(allErrs, field.Invalid(path.Child("secret"), asset.Secret, msg))
+		}
+	}
+	if asset.Key == "" {
+		allErrs = append(allErrs, field.Required(path.Child("key"), ""))
+	} else {
+		for _, msg := range validation.IsConfigMapKey(asset.Key) {
+			allErrs = append(allErrs, field.Invalid(path.Child("key"), asset.Key, msg))
+		}
+	}
+	return allErrs
+}
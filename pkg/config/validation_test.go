@@ -0,0 +1,121 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"os"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestValidateShippedConfig(t *testing.T) {
+	content, err := os.ReadFile("../../artifacts/dashboard/karmada-dashboard-configmap.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var configMap v1.ConfigMap
+	if err := yaml.Unmarshal(content, &configMap); err != nil {
+		t.Fatal(err)
+	}
+	for key, data := range configMap.Data {
+		var dashboardConfig DashboardConfig
+		if err := yaml.Unmarshal([]byte(data), &dashboardConfig); err != nil {
+			t.Fatalf("%s: %v", key, err)
+		}
+		if errs := ValidateDashboardConfig(dashboardConfig); len(errs) > 0 {
+			t.Errorf("%s: unexpected errors %v", key, errs)
+		}
+	}
+}
+
+func TestValidateDashboardConfig(t *testing.T) {
+	dashboardConfig := DashboardConfig{
+		APIVersion: "v2",
+		PathPrefix: "dashboard",
+		DockerRegistries: []DockerRegistry{
+			{Name: "hub", URL: "docker.io"},
+			{Name: "hub", URL: "ftp://registry.local"},
+		},
+		ChartRegistries: []ChartRegistry{{Name: "hub", URL: "https://charts.local"}},
+		MenuConfigs: []MenuConfig{
+			{Path: "/overview", SidebarKey: "OVERVIEW"},
+			{Path: "cluster", SidebarKey: "CLUSTER", Children: []MenuConfig{{Path: "/list", SidebarKey: "OVERVIEW"}}},
+		},
+		Roles:        []Role{{Name: "auditor", Rules: []RoleRule{{Verbs: []string{"delete"}, Resources: []string{"*"}}}}},
+		RoleBindings: []RoleBinding{{Role: "auditor", Users: []string{"alice"}}, {Role: "missing"}},
+		DefaultRole:  "viewer",
+		FeatureGates: map[string]bool{"PreciseScheduling": false, "Unknown": true},
+	}
+	got := map[string]bool{}
+	for _, err := range ValidateDashboardConfig(dashboardConfig) {
+		got[err.Field] = true
+	}
+	for _, field := range []string{
+		"api_version", "path_prefix", "docker_registries[1].name", "docker_registries[1].url",
+		"menu_configs[1].path", "menu_configs[1].children[0].path", "menu_configs[1].children[0].sidebar_key",
+		"roles[0].rules[0].verbs[0]", "role_bindings[1].role", "role_bindings[1]",
+		"feature_gates[Unknown]",
+	} {
+		if !got[field] {
+			t.Errorf("expected an error for %s, got %v", field, got)
+		}
+	}
+	if len(got) != 11 {
+		t.Errorf("expected exactly 11 invalid fields, got %v", got)
+	}
+}
+
+func TestValidateBranding(t *testing.T) {
+	dashboardConfig := DashboardConfig{Branding: &Branding{
+		ProductName: "Acme Fleet",
+		Logo:        &BrandingAsset{ConfigMap: "acme-branding", Key: "logo.svg"},
+		Favicon:     &BrandingAsset{ConfigMap: "acme-branding", Secret: "acme", Key: "../favicon.ico"},
+		Theme:       BrandingTheme{PrimaryColor: "#326ce5", HeaderBackgroundColor: "blue"},
+		FooterLinks: []FooterLink{
+			{Text: "Docs", URL: "https://docs.acme.io"},
+			{Text: "Support", URL: "/support"},
+			{Text: "Bad", URL: "javascript:alert(1)"},
+			{Text: "Other host", URL: "//evil.io"},
+		},
+	}}
+	got := map[string]bool{}
+	for _, err := range ValidateDashboardConfig(dashboardConfig) {
+		got[err.Field] = true
+	}
+	expected := []string{
+		"branding.favicon.secret", "branding.favicon.key", "branding.theme.header_background_color",
+		"branding.footer_links[2].url", "branding.footer_links[3].url",
+	}
+	for _, field := range expected {
+		if !got[field] {
+			t.Errorf("expected an error for %s, got %v", field, got)
+		}
+	}
+	if len(got) != len(expected) {
+		t.Errorf("expected exactly %d invalid fields, got %v", len(expected), got)
+	}
+
+	assetPath, err := dashboardConfig.Branding.Logo.Path("/branding")
+	if err != nil || assetPath != "/branding/configmaps/acme-branding/logo.svg" {
+		t.Errorf("unexpected logo path %q, %v", assetPath, err)
+	}
+	if _, err := dashboardConfig.Branding.Favicon.Path("/branding"); err == nil {
+		t.Errorf("expected an error for the invalid favicon")
+	}
+}
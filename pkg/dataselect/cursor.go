@@ -0,0 +1,114 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataselect
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Cursor is the position of a page of cursor pagination.
+type Cursor struct {
+	// Continue is the token of the next page, empty on the last page.
+	Continue string
+	// RemainingItemCount is the number of items after the page, nil if the API server did not estimate it.
+	RemainingItemCount *int64
+}
+
+// offsetToken is the continue token of pages cut from a completely loaded list.
+type offsetToken struct {
+	Offset int `json:"offset"`
+}
+
+func encodeOffsetToken(offset int) string {
+	buff, _ := json.Marshal(offsetToken{Offset: offset})
+	return base64.RawURLEncoding.EncodeToString(buff)
+}
+
+func decodeOffsetToken(continueToken string) (int, error) {
+	if continueToken == "" {
+		return 0, nil
+	}
+	buff, err := base64.RawURLEncoding.DecodeString(continueToken)
+	if err != nil {
+		return 0, apierrors.NewBadRequest("invalid continue token")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(buff))
+	// tokens of the API server are JSON as well
+	decoder.DisallowUnknownFields()
+	var token offsetToken
+	if err := decoder.Decode(&token); err != nil || token.Offset < 0 {
+		return 0, apierrors.NewBadRequest("invalid continue token")
+	}
+	return token.Offset, nil
+}
+
+// ForwardsCursor returns true if the API server can paginate the list for the cursor pagination query,
+// which needs the API server to evaluate all filters and to return the list in its own order. Lists
+// read with more than one request or filtered afterwards must not forward the cursor either.
+func (q *DataSelectQuery) ForwardsCursor() bool {
+	return q.PaginationQuery.IsCursorPagination() && len(q.SortQuery.SortByList) == 0 && q.FilterQuery.evaluatedByAPIServer()
+}
+
+// CursorListOptions returns the ListOptions of the filter query with the limit and continue token of
+// the cursor, for queries that ForwardsCursor.
+func (q *DataSelectQuery) CursorListOptions() metaV1.ListOptions {
+	options := q.FilterQuery.ListOptions()
+	options.Limit = q.PaginationQuery.Limit
+	options.Continue = q.PaginationQuery.Continue
+	return options
+}
+
+// GenericDataSelectWithCursor is GenericDataSelectWithFilter supporting cursor pagination. forwarded is
+// the ListMeta of the list if it was read with CursorListOptions, the cells are the page then. Otherwise
+// the page is cut from the selected cells and the continue token holds the offset of the next page.
+// The total is the number of selected cells, for forwarded cursors it counts the page and the remaining
+// items, if estimated.
+func GenericDataSelectWithCursor(dataList []DataCell, dsQuery *DataSelectQuery, forwarded *metaV1.ListMeta) ([]DataCell, int, Cursor, error) {
+	if !dsQuery.PaginationQuery.IsCursorPagination() {
+		selected, filteredTotal := GenericDataSelectWithFilter(dataList, dsQuery)
+		return selected, filteredTotal, Cursor{}, nil
+	}
+	selectableData := DataSelector{
+		GenericDataList: dataList,
+		DataSelectQuery: dsQuery,
+	}
+	if forwarded != nil {
+		page := selectableData.Filter().GenericDataList
+		total := len(page)
+		if forwarded.RemainingItemCount != nil {
+			total += int(*forwarded.RemainingItemCount)
+		}
+		return page, total, Cursor{Continue: forwarded.Continue, RemainingItemCount: forwarded.RemainingItemCount}, nil
+	}
+
+	offset, err := decodeOffsetToken(dsQuery.PaginationQuery.Continue)
+	if err != nil {
+		return nil, 0, Cursor{}, err
+	}
+	selected := selectableData.Filter().Sort().GenericDataList
+	startIndex := min(offset, len(selected))
+	endIndex := min(startIndex+int(dsQuery.PaginationQuery.Limit), len(selected))
+	remaining := int64(len(selected) - endIndex)
+	cursor := Cursor{RemainingItemCount: &remaining}
+	if remaining > 0 {
+		cursor.Continue = encodeOffsetToken(endIndex)
+	}
+	return selected[startIndex:endIndex], len(selected), cursor, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataselect
+
+import (
+	"reflect"
+	"testing"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGenericDataSelectWithCursor(t *testing.T) {
+	query := NewDataSelectQuery(NewCursorPaginationQuery(4, ""), NewSortQuery([]string{"d", "creationTimestamp"}), NoFilter)
+	var pages [][]int
+	for {
+		selected, total, cursor, err := GenericDataSelectWithCursor(getDataCellList(), query, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if total != 10 {
+			t.Errorf("expected the total of the list, got %d", total)
+		}
+		pages = append(pages, getOrder(fromCells(selected)))
+		if cursor.Continue == "" {
+			if *cursor.RemainingItemCount != 0 {
+				t.Errorf("expected no remaining items on the last page, got %d", *cursor.RemainingItemCount)
+			}
+			break
+		}
+		query.PaginationQuery = NewCursorPaginationQuery(4, cursor.Continue)
+	}
+	if expected := [][]int{{10, 9, 8, 7}, {6, 5, 4, 3}, {2, 1}}; !reflect.DeepEqual(pages, expected) {
+		t.Errorf("got pages %v, expected %v", pages, expected)
+	}
+
+	for _, token := range []string{"not-a-token", encodeOffsetToken(-1), "eyJ2IjoibWV0YS5rOHMuaW8vdjEiLCJydiI6MTB9"} {
+		query.PaginationQuery = NewCursorPaginationQuery(4, token)
+		if _, _, _, err := GenericDataSelectWithCursor(getDataCellList(), query, nil); err == nil {
+			t.Errorf("expected an error for the continue token %q", token)
+		}
+	}
+
+	remaining := int64(20)
+	forwarded := &metaV1.ListMeta{Continue: "next", RemainingItemCount: &remaining}
+	query = NewDataSelectQuery(NewCursorPaginationQuery(10, ""), NoSort, NoFilter)
+	selected, total, cursor, err := GenericDataSelectWithCursor(getDataCellList(), query, forwarded)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(selected) != 10 || total != 30 || cursor.Continue != "next" || *cursor.RemainingItemCount != 20 {
+		t.Errorf("expected the forwarded page as is, got %d items of %d and %+v", len(selected), total, cursor)
+	}
+}
+
+func TestForwardsCursor(t *testing.T) {
+	labelSelected, _ := NoFilter.WithSelectors("app=nginx", "metadata.namespace=default")
+	fieldSelected, _ := NoFilter.WithSelectors("", "status.phase=Running")
+	cases := []struct {
+		info     string
+		query    *DataSelectQuery
+		expected bool
+	}{
+		{"page based pagination", NewDataSelectQuery(NewPaginationQuery(10, 0), NoSort, NoFilter), false},
+		{"cursor", NewDataSelectQuery(NewCursorPaginationQuery(10, ""), NoSort, NoFilter), true},
+		{"selectors of the API server", NewDataSelectQuery(NewCursorPaginationQuery(10, ""), NoSort, labelSelected), true},
+		{"sort", NewDataSelectQuery(NewCursorPaginationQuery(10, ""), NewSortQuery([]string{"a", "name"}), NoFilter), false},
+		{"filterBy", NewDataSelectQuery(NewCursorPaginationQuery(10, ""), NoSort, NewFilterQuery([]string{"name", "a"})), false},
+		{"other fields", NewDataSelectQuery(NewCursorPaginationQuery(10, ""), NoSort, fieldSelected), false},
+	}
+	for _, c := range cases {
+		if actual := c.query.ForwardsCursor(); actual != c.expected {
+			t.Errorf("ForwardsCursor() for %s == %v, expected %v", c.info, actual, c.expected)
+		}
+	}
+
+	options := NewDataSelectQuery(NewCursorPaginationQuery(10, "token"), NoSort, labelSelected).CursorListOptions()
+	if options.Limit != 10 || options.Continue != "token" || options.LabelSelector != "app=nginx" {
+		t.Errorf("unexpected list options %+v", options)
+	}
+}
@@ -16,6 +16,15 @@ package dataselect
 
 import (
 	"sort"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// Fields supported by field selectors for all cells.
+const (
+	NameField      = "metadata.name"
+	NamespaceField = "metadata.namespace"
 )
 
 // DataCell describes the interface of the data cell that contains all the necessary methods needed to perform
@@ -28,6 +37,24 @@ type DataCell interface {
 	GetProperty(PropertyName) ComparableValue
 }
 
+// SelectableCell is a DataCell of an object that can be matched by label and field selectors.
+type SelectableCell interface {
+	DataCell
+	// GetLabels returns the labels of the object.
+	GetLabels() map[string]string
+	// GetFields returns the fields of the object supported by field selectors, at least NameField and
+	// NamespaceField.
+	GetFields() fields.Set
+}
+
+// ObjectMetaFields returns the fields of the object metadata supported by field selectors.
+func ObjectMetaFields(objectMeta metaV1.ObjectMeta) fields.Set {
+	return fields.Set{
+		NameField:      objectMeta.Name,
+		NamespaceField: objectMeta.Namespace,
+	}
+}
+
 // ComparableValue hold any value that can be compared to its own kind.
 type ComparableValue interface {
 	// Compares self with other value. Returns 1 if other value is smaller, 0 if they are the same, -1 if other is larger.
@@ -85,15 +112,7 @@ func (s *DataSelector) Filter() *DataSelector {
 	filteredList := []DataCell{}
 
 	for _, c := range s.GenericDataList {
-		matches := true
-		for _, filterBy := range s.DataSelectQuery.FilterQuery.FilterByList {
-			v := c.GetProperty(filterBy.Property)
-			if v == nil || !v.Contains(filterBy.Value) {
-				matches = false
-				break
-			}
-		}
-		if matches {
+		if s.DataSelectQuery.FilterQuery.Matches(c) {
 			filteredList = append(filteredList, c)
 		}
 	}
@@ -14,6 +14,17 @@
 
 package dataselect
 
+import (
+	"regexp"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
+)
+
 // DataSelectQuery is options for GenericDataSelect which takes []GenericDataCell and returns selected data.
 // Can be extended to include any kind of selection - for example filtering.
 // Currently included only Pagination and Sort options.
@@ -51,16 +62,128 @@ var NoSort = &SortQuery{
 type FilterQuery struct {
 	// FilterByList is a list of filter criteria for data selection.
 	FilterByList []FilterBy
+	// LabelSelector selects cells by the labels of their object, nil selects everything.
+	LabelSelector labels.Selector
+	// FieldSelector selects cells by the fields of their object, nil selects everything.
+	FieldSelector fields.Selector
 }
 
 // FilterBy defines a filter criterion for data selection.
-// It specifies a property to filter on and the value to compare against.
+// It specifies a property to filter on, the operator and the value to compare against.
 type FilterBy struct {
 	// Property is the name of the field or attribute to filter by.
 	Property PropertyName
 
+	// Operator is the predicate applied to the property, FilterContains if empty.
+	Operator FilterOperator
+
+	// Negate inverts the result of the operator.
+	Negate bool
+
 	// Value is the comparable value to match against the specified property.
 	Value ComparableValue
+
+	// Values are the comparable values of FilterIn.
+	Values []ComparableValue
+
+	// pattern is the compiled Value of FilterRegex.
+	pattern *regexp.Regexp
+}
+
+// Matches returns true if the cell matches all filters and selectors of the query. Cells that are not
+// SelectableCells only match empty selectors.
+func (q *FilterQuery) Matches(cell DataCell) bool {
+	for _, filterBy := range q.FilterByList {
+		if !filterBy.Matches(cell) {
+			return false
+		}
+	}
+	if q.selectsEverything() {
+		return true
+	}
+	selectable, ok := cell.(SelectableCell)
+	if !ok {
+		return false
+	}
+	if q.LabelSelector != nil && !q.LabelSelector.Matches(labels.Set(selectable.GetLabels())) {
+		return false
+	}
+	return q.FieldSelector == nil || q.FieldSelector.Matches(selectable.GetFields())
+}
+
+func (q *FilterQuery) selectsEverything() bool {
+	return (q.LabelSelector == nil || q.LabelSelector.Empty()) && (q.FieldSelector == nil || q.FieldSelector.Empty())
+}
+
+// WithSelectors returns a copy of the query that also requires the label and field selectors, given in
+// the syntax of the Kubernetes API. Label selectors may be set-based, e.g. "env in (prod,qa),!canary".
+func (q *FilterQuery) WithSelectors(labelSelector, fieldSelector string) (*FilterQuery, error) {
+	parsedLabelSelector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	parsedFieldSelector, err := fields.ParseSelector(fieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &FilterQuery{
+		FilterByList:  q.FilterByList,
+		LabelSelector: parsedLabelSelector,
+		FieldSelector: parsedFieldSelector,
+	}, nil
+}
+
+// ListOptions returns the list options pushing the selectors of the query down to the API server. The
+// label selector is passed as is, only the requirements on metadata.name and metadata.namespace are
+// passed of the field selector, as other fields are not supported by all resources. Lists must still
+// be filtered with Matches.
+func (q *FilterQuery) ListOptions() metaV1.ListOptions {
+	options := helpers.ListEverything
+	if q.LabelSelector != nil {
+		options.LabelSelector = q.LabelSelector.String()
+	}
+	if q.FieldSelector == nil {
+		return options
+	}
+	var selectors []fields.Selector
+	for _, requirement := range q.FieldSelector.Requirements() {
+		if selector, ok := pushedDownFieldSelector(requirement); ok {
+			selectors = append(selectors, selector)
+		}
+	}
+	options.FieldSelector = fields.AndSelectors(selectors...).String()
+	return options
+}
+
+// pushedDownFieldSelector returns the selector of the requirement passed to the API server by ListOptions.
+func pushedDownFieldSelector(requirement fields.Requirement) (fields.Selector, bool) {
+	if requirement.Field != NameField && requirement.Field != NamespaceField {
+		return nil, false
+	}
+	switch requirement.Operator {
+	case selection.Equals, selection.DoubleEquals:
+		return fields.OneTermEqualSelector(requirement.Field, requirement.Value), true
+	case selection.NotEquals:
+		return fields.OneTermNotEqualSelector(requirement.Field, requirement.Value), true
+	default:
+		return nil, false
+	}
+}
+
+// evaluatedByAPIServer returns true if ListOptions passes all filters of the query to the API server.
+func (q *FilterQuery) evaluatedByAPIServer() bool {
+	if len(q.FilterByList) > 0 {
+		return false
+	}
+	if q.FieldSelector == nil {
+		return true
+	}
+	for _, requirement := range q.FieldSelector.Requirements() {
+		if _, ok := pushedDownFieldSelector(requirement); !ok {
+			return false
+		}
+	}
+	return true
 }
 
 // NoFilter is an option for no filter.
@@ -118,24 +241,32 @@ func NewSortQuery(sortByListRaw []string) *SortQuery {
 }
 
 // NewFilterQuery takes raw filter options list and returns FilterQuery object. For example:
-// ["parameter1", "value1", "parameter2", "value2"] - means that the data should be filtered by
-// parameter1 equals value1 and parameter2 equals value2
+// ["parameter1", "value1", "parameter2:gt", "value2"] - means that the data should be filtered by
+// parameter1 containing value1 and parameter2 greater than value2. Invalid filters are ignored.
 func NewFilterQuery(filterByListRaw []string) *FilterQuery {
-	if filterByListRaw == nil || len(filterByListRaw)%2 == 1 {
+	filterQuery, err := ParseFilterQuery(filterByListRaw)
+	if err != nil {
 		return NoFilter
 	}
+	return filterQuery
+}
+
+// ParseFilterQuery is NewFilterQuery returning an error for unknown operators and for values that do
+// not parse as the type of their property, see FilterOperator and PropertyType.
+func ParseFilterQuery(filterByListRaw []string) (*FilterQuery, error) {
+	if filterByListRaw == nil || len(filterByListRaw)%2 == 1 {
+		return NoFilter, nil
+	}
 	filterByList := []FilterBy{}
 	for i := 0; i+1 < len(filterByListRaw); i += 2 {
-		propertyName := filterByListRaw[i]
-		propertyValue := filterByListRaw[i+1]
-		filterBy := FilterBy{
-			Property: PropertyName(propertyName),
-			Value:    StdComparableString(propertyValue),
+		filterBy, err := parseFilterBy(filterByListRaw[i], filterByListRaw[i+1])
+		if err != nil {
+			return nil, err
 		}
 		// Add to the filter options.
 		filterByList = append(filterByList, filterBy)
 	}
 	return &FilterQuery{
 		FilterByList: filterByList,
-	}
+	}, nil
 }
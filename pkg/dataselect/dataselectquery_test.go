@@ -0,0 +1,181 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataselect
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+type SelectableTestDataCell struct {
+	TestDataCell
+	Labels map[string]string
+	Phase  string
+}
+
+func (c SelectableTestDataCell) GetLabels() map[string]string {
+	return c.Labels
+}
+
+func (c SelectableTestDataCell) GetFields() fields.Set {
+	return fields.Set{NameField: c.Name, NamespaceField: "default", "status.phase": c.Phase}
+}
+
+func TestFilterSelectors(t *testing.T) {
+	cells := []DataCell{
+		SelectableTestDataCell{TestDataCell{"a", 1}, map[string]string{"env": "prod", "canary": "true"}, "Running"},
+		SelectableTestDataCell{TestDataCell{"b", 2}, map[string]string{"env": "qa"}, "Pending"},
+		SelectableTestDataCell{TestDataCell{"c", 3}, map[string]string{"env": "dev"}, "Running"},
+		SelectableTestDataCell{TestDataCell{"d", 4}, nil, "Running"},
+		TestDataCell{"e", 5},
+	}
+	testCases := []struct {
+		Info          string
+		LabelSelector string
+		FieldSelector string
+		ExpectedOrder []int
+	}{
+		{"no selectors - all cells are selected", "", "", []int{1, 2, 3, 4, 5}},
+		{"equality label selector", "env=qa", "", []int{2}},
+		{"set-based label selector", "env in (prod,qa),!canary", "", []int{2}},
+		{"existence label selector", "env", "", []int{1, 2, 3}},
+		{"field selector", "", "status.phase=Running,metadata.name!=a", []int{3, 4}},
+		{"label and field selector", "env notin (dev)", "status.phase=Running", []int{1, 4}},
+	}
+	for _, testCase := range testCases {
+		filterQuery, err := NoFilter.WithSelectors(testCase.LabelSelector, testCase.FieldSelector)
+		if err != nil {
+			t.Fatalf("Filter: %s. Unexpected error: %v", testCase.Info, err)
+		}
+		var order []int
+		selected, _ := GenericDataSelectWithFilter(cells, NewDataSelectQuery(NoPagination, NoSort, filterQuery))
+		for _, cell := range selected {
+			switch cell := cell.(type) {
+			case SelectableTestDataCell:
+				order = append(order, cell.ID)
+			case TestDataCell:
+				order = append(order, cell.ID)
+			}
+		}
+		if !reflect.DeepEqual(order, testCase.ExpectedOrder) {
+			t.Errorf("Filter: %s. Got %v, expected %v.", testCase.Info, order, testCase.ExpectedOrder)
+		}
+	}
+
+	if _, err := NoFilter.WithSelectors("env in (prod", ""); err == nil {
+		t.Errorf("expected an error for an invalid label selector")
+	}
+	if _, err := NoFilter.WithSelectors("", "status.phase in (Running)"); err == nil {
+		t.Errorf("expected an error for an invalid field selector")
+	}
+}
+
+func TestFilterQueryListOptions(t *testing.T) {
+	if options := NoFilter.ListOptions(); options.LabelSelector != "" || options.FieldSelector != "" {
+		t.Errorf("expected no selectors without a query, got %+v", options)
+	}
+	filterQuery, err := NoFilter.WithSelectors("env in (prod,qa)", "metadata.name=a,status.phase=Running,metadata.namespace!=kube-system")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	options := filterQuery.ListOptions()
+	if options.LabelSelector != "env in (prod,qa)" {
+		t.Errorf("expected the label selector to be passed, got %q", options.LabelSelector)
+	}
+	if options.FieldSelector != "metadata.name=a,metadata.namespace!=kube-system" {
+		t.Errorf("expected only the metadata fields to be passed, got %q", options.FieldSelector)
+	}
+}
+
+type FilterTestDataCell struct {
+	Name     string
+	Restarts int
+	Ready    bool
+	Created  time.Time
+}
+
+func (c FilterTestDataCell) GetProperty(name PropertyName) ComparableValue {
+	switch name {
+	case NameProperty:
+		return StdComparableString(c.Name)
+	case RestartsProperty:
+		return StdComparableInt(c.Restarts)
+	case ReadyProperty:
+		return StdComparableBool(c.Ready)
+	case CreationTimestampProperty:
+		return StdComparableTime(c.Created)
+	default:
+		return nil
+	}
+}
+
+func TestFilterOperators(t *testing.T) {
+	date := func(day int) time.Time { return time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC) }
+	cells := []DataCell{
+		FilterTestDataCell{"kube-proxy", 0, true, date(1)},
+		FilterTestDataCell{"nginx", 7, false, date(2)},
+		FilterTestDataCell{"nginx-canary", 3, false, date(3)},
+		TestDataCell{"other", 4},
+	}
+	testCases := []struct {
+		Info          string
+		FilterBy      []string
+		ExpectedNames []string
+	}{
+		{"contains", []string{"name", "ginx"}, []string{"nginx", "nginx-canary"}},
+		{"equal", []string{"name:eq", "nginx"}, []string{"nginx"}},
+		{"not equal", []string{"name:ne", "nginx"}, []string{"kube-proxy", "nginx-canary", "other"}},
+		{"prefix", []string{"name:prefix", "kube-"}, []string{"kube-proxy"}},
+		{"negated prefix", []string{"name:!prefix", "kube-"}, []string{"nginx", "nginx-canary", "other"}},
+		{"regex", []string{"name:regex", "^nginx(-canary)?$"}, []string{"nginx", "nginx-canary"}},
+		{"greater than", []string{"restarts:gt", "5"}, []string{"nginx"}},
+		{"less equal", []string{"restarts:le", "3"}, []string{"kube-proxy", "nginx-canary"}},
+		{"in", []string{"restarts:in", "0|7"}, []string{"kube-proxy", "nginx"}},
+		{"exists", []string{"restarts:exists", ""}, []string{"kube-proxy", "nginx", "nginx-canary"}},
+		{"not exists", []string{"restarts:!exists", ""}, []string{"other"}},
+		{"not ready and created before", []string{"ready:eq", "false", "creationTimestamp:lt", "2024-01-03T00:00:00Z"}, []string{"nginx"}},
+		{"created at or after a date", []string{"creationTimestamp:ge", "2024-01-02"}, []string{"nginx", "nginx-canary"}},
+	}
+	for _, testCase := range testCases {
+		filterQuery, err := ParseFilterQuery(testCase.FilterBy)
+		if err != nil {
+			t.Fatalf("Filter: %s. Unexpected error: %v", testCase.Info, err)
+		}
+		selected, _ := GenericDataSelectWithFilter(cells, NewDataSelectQuery(NoPagination, NoSort, filterQuery))
+		var names []string
+		for _, cell := range selected {
+			names = append(names, string(cell.GetProperty(NameProperty).(StdComparableString)))
+		}
+		if !reflect.DeepEqual(names, testCase.ExpectedNames) {
+			t.Errorf("Filter: %s. Got %v, expected %v.", testCase.Info, names, testCase.ExpectedNames)
+		}
+	}
+
+	for _, invalid := range [][]string{
+		{"name:like", "x"},
+		{"restarts:gt", "many"},
+		{"ready:eq", "maybe"},
+		{"creationTimestamp:lt", "yesterday"},
+		{"restarts:prefix", "1"},
+		{"name:regex", "("},
+	} {
+		if _, err := ParseFilterQuery(invalid); err == nil {
+			t.Errorf("expected an error for the filter %v", invalid)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dataselect
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// FilterOperator is the predicate a filter applies to the property of a cell.
+type FilterOperator string
+
+// List of all filter operators. Filters are written as "property:operator,value" in a filter list,
+// "property,value" uses FilterContains and "property:!operator,value" negates the operator.
+const (
+	// FilterContains matches string properties containing the value and other properties equal to it.
+	FilterContains FilterOperator = "contains"
+	FilterEqual    FilterOperator = "eq"
+	FilterNotEqual FilterOperator = "ne"
+	// FilterPrefix matches string properties starting with the value.
+	FilterPrefix FilterOperator = "prefix"
+	// FilterRegex matches string properties matching the regular expression of the value.
+	FilterRegex        FilterOperator = "regex"
+	FilterGreaterThan  FilterOperator = "gt"
+	FilterGreaterEqual FilterOperator = "ge"
+	FilterLessThan     FilterOperator = "lt"
+	FilterLessEqual    FilterOperator = "le"
+	// FilterIn matches properties equal to one of the values, they are separated by "|".
+	FilterIn FilterOperator = "in"
+	// FilterExists matches cells having the property, the value is ignored.
+	FilterExists FilterOperator = "exists"
+)
+
+// filterInSeparator separates the values of FilterIn, commas already separate the filters.
+const filterInSeparator = "|"
+
+var filterOperators = map[FilterOperator]bool{
+	FilterContains: true, FilterEqual: true, FilterNotEqual: true, FilterPrefix: true, FilterRegex: true,
+	FilterGreaterThan: true, FilterGreaterEqual: true, FilterLessThan: true, FilterLessEqual: true,
+	FilterIn: true, FilterExists: true,
+}
+
+// Matches returns true if the property of the cell satisfies the filter.
+func (f FilterBy) Matches(cell DataCell) bool {
+	return f.matches(cell.GetProperty(f.Property)) != f.Negate
+}
+
+func (f FilterBy) matches(v ComparableValue) bool {
+	if f.Operator == FilterExists {
+		return v != nil
+	}
+	if v == nil {
+		return false
+	}
+	if f.Operator == FilterIn {
+		for _, value := range f.Values {
+			if sameType(v, value) && v.Compare(value) == 0 {
+				return true
+			}
+		}
+		return false
+	}
+	if f.Value == nil || !sameType(v, f.Value) {
+		return false
+	}
+
+	switch f.Operator {
+	case FilterEqual:
+		return v.Compare(f.Value) == 0
+	case FilterNotEqual:
+		return v.Compare(f.Value) != 0
+	case FilterGreaterThan:
+		return v.Compare(f.Value) > 0
+	case FilterGreaterEqual:
+		return v.Compare(f.Value) >= 0
+	case FilterLessThan:
+		return v.Compare(f.Value) < 0
+	case FilterLessEqual:
+		return v.Compare(f.Value) <= 0
+	case FilterPrefix:
+		return strings.HasPrefix(string(v.(StdComparableString)), string(f.Value.(StdComparableString)))
+	case FilterRegex:
+		pattern := f.pattern
+		if pattern == nil {
+			var err error
+			if pattern, err = regexp.Compile(string(f.Value.(StdComparableString))); err != nil {
+				return false
+			}
+		}
+		return pattern.MatchString(string(v.(StdComparableString)))
+	default:
+		return v.Contains(f.Value)
+	}
+}
+
+// sameType returns whether the values can be compared, the Compare methods expect values of their own kind.
+func sameType(a, b ComparableValue) bool {
+	return reflect.TypeOf(a) == reflect.TypeOf(b)
+}
+
+// parseFilterBy parses the property with an optional operator, e.g. "restarts:gt", and the value of a filter.
+func parseFilterBy(property, value string) (FilterBy, error) {
+	propertyName, operatorName, _ := strings.Cut(property, ":")
+	filterBy := FilterBy{Property: PropertyName(propertyName), Operator: FilterContains}
+	if operatorName != "" {
+		filterBy.Negate = strings.HasPrefix(operatorName, "!")
+		filterBy.Operator = FilterOperator(strings.TrimPrefix(operatorName, "!"))
+	}
+	if !filterOperators[filterBy.Operator] {
+		return filterBy, fmt.Errorf("unknown filter operator %q of property %s", filterBy.Operator, propertyName)
+	}
+
+	propertyType := GetPropertyType(filterBy.Property)
+	switch filterBy.Operator {
+	case FilterExists:
+		return filterBy, nil
+	case FilterIn:
+		for _, rawValue := range strings.Split(value, filterInSeparator) {
+			parsed, err := ParsePropertyValue(filterBy.Property, rawValue)
+			if err != nil {
+				return filterBy, err
+			}
+			filterBy.Values = append(filterBy.Values, parsed)
+		}
+		return filterBy, nil
+	case FilterPrefix, FilterRegex:
+		if propertyType != StringPropertyType {
+			return filterBy, fmt.Errorf("filter operator %s is not supported by the %s property %s", filterBy.Operator, propertyType, propertyName)
+		}
+	}
+
+	parsed, err := ParsePropertyValue(filterBy.Property, value)
+	if err != nil {
+		return filterBy, err
+	}
+	filterBy.Value = parsed
+	if filterBy.Operator == FilterRegex {
+		if filterBy.pattern, err = regexp.Compile(value); err != nil {
+			return filterBy, fmt.Errorf("invalid regular expression for property %s: %w", propertyName, err)
+		}
+	}
+	return filterBy, nil
+}
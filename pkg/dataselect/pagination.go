@@ -29,11 +29,26 @@ type PaginationQuery struct {
 	ItemsPerPage int
 	// Number of page that should be returned when pagination is applied to the list
 	Page int
+	// Limit is the number of items of a page in cursor pagination, 0 selects page based pagination
+	Limit int64
+	// Continue is the token of the page in cursor pagination, empty for the first page
+	Continue string
 }
 
 // NewPaginationQuery return pagination query structure based on given parameters
 func NewPaginationQuery(itemsPerPage, page int) *PaginationQuery {
-	return &PaginationQuery{itemsPerPage, page}
+	return &PaginationQuery{ItemsPerPage: itemsPerPage, Page: page}
+}
+
+// NewCursorPaginationQuery returns a cursor pagination query for the page of limit items starting at
+// the continue token, see GenericDataSelectWithCursor.
+func NewCursorPaginationQuery(limit int64, continueToken string) *PaginationQuery {
+	return &PaginationQuery{ItemsPerPage: -1, Page: -1, Limit: limit, Continue: continueToken}
+}
+
+// IsCursorPagination returns true if the query selects a page by continue token instead of page number
+func (p *PaginationQuery) IsCursorPagination() bool {
+	return p.Limit > 0
 }
 
 // IsValidPagination returns true if pagination has non negative parameters
@@ -24,8 +24,8 @@ func TestNewPaginationQuery(t *testing.T) {
 		itemsPerPage, page int
 		expected           *PaginationQuery
 	}{
-		{0, 0, &PaginationQuery{0, 0}},
-		{1, 10, &PaginationQuery{1, 10}},
+		{0, 0, &PaginationQuery{ItemsPerPage: 0, Page: 0}},
+		{1, 10, &PaginationQuery{ItemsPerPage: 1, Page: 10}},
 	}
 
 	for _, c := range cases {
@@ -42,13 +42,13 @@ func TestIsValidPagination(t *testing.T) {
 		pQuery   *PaginationQuery
 		expected bool
 	}{
-		{&PaginationQuery{0, 0}, true},
-		{&PaginationQuery{5, 0}, true},
-		{&PaginationQuery{10, 1}, true},
-		{&PaginationQuery{0, 2}, true},
-		{&PaginationQuery{10, -1}, false},
-		{&PaginationQuery{-1, 0}, false},
-		{&PaginationQuery{-1, -1}, false},
+		{&PaginationQuery{ItemsPerPage: 0, Page: 0}, true},
+		{&PaginationQuery{ItemsPerPage: 5, Page: 0}, true},
+		{&PaginationQuery{ItemsPerPage: 10, Page: 1}, true},
+		{&PaginationQuery{ItemsPerPage: 0, Page: 2}, true},
+		{&PaginationQuery{ItemsPerPage: 10, Page: -1}, false},
+		{&PaginationQuery{ItemsPerPage: -1, Page: 0}, false},
+		{&PaginationQuery{ItemsPerPage: -1, Page: -1}, false},
 	}
 
 	for _, c := range cases {
@@ -65,9 +65,9 @@ func TestGetPaginationSettings(t *testing.T) {
 		itemsCount           int
 		startIndex, endIndex int
 	}{
-		{&PaginationQuery{0, 0}, 10, 0, 0},
-		{&PaginationQuery{10, 1}, 10, 10, 10},
-		{&PaginationQuery{10, 0}, 10, 0, 10},
+		{&PaginationQuery{ItemsPerPage: 0, Page: 0}, 10, 0, 0},
+		{&PaginationQuery{ItemsPerPage: 10, Page: 1}, 10, 10, 10},
+		{&PaginationQuery{ItemsPerPage: 10, Page: 0}, 10, 0, 10},
 	}
 
 	for _, c := range cases {
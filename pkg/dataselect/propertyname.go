@@ -14,6 +14,12 @@
 
 package dataselect
 
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
 // PropertyName is used to get the value of certain property of data cell.
 // For example if we want to get the namespace of certain Deployment we can use DeploymentCell.GetProperty(NamespaceProperty)
 type PropertyName string
@@ -28,4 +34,63 @@ const (
 	FirstSeenProperty         = "firstSeen"
 	LastSeenProperty          = "lastSeen"
 	ReasonProperty            = "reason"
+	ReadyProperty             = "ready"
+	RestartsProperty          = "restarts"
 )
+
+// PropertyType is the type of the values of a property, filter values are parsed accordingly.
+type PropertyType string
+
+// List of all property types.
+const (
+	StringPropertyType PropertyType = "string"
+	IntPropertyType    PropertyType = "int"
+	BoolPropertyType   PropertyType = "bool"
+	// TimePropertyType values are RFC3339 timestamps or dates like 2006-01-02.
+	TimePropertyType PropertyType = "time"
+)
+
+// propertyTypes holds the properties that are not strings.
+var propertyTypes = map[PropertyName]PropertyType{
+	CreationTimestampProperty: TimePropertyType,
+	FirstSeenProperty:         TimePropertyType,
+	LastSeenProperty:          TimePropertyType,
+	ReadyProperty:             BoolPropertyType,
+	RestartsProperty:          IntPropertyType,
+}
+
+// GetPropertyType returns the type of the property, properties are strings unless listed in propertyTypes.
+func GetPropertyType(name PropertyName) PropertyType {
+	if propertyType, ok := propertyTypes[name]; ok {
+		return propertyType
+	}
+	return StringPropertyType
+}
+
+// ParsePropertyValue parses a filter value as the comparable value cells return for the property.
+func ParsePropertyValue(name PropertyName, value string) (ComparableValue, error) {
+	switch GetPropertyType(name) {
+	case IntPropertyType:
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid int value %q for property %s", value, name)
+		}
+		return StdComparableInt(i), nil
+	case BoolPropertyType:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bool value %q for property %s", value, name)
+		}
+		return StdComparableBool(b), nil
+	case TimePropertyType:
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			if t, err = time.Parse(time.DateOnly, value); err != nil {
+				return nil, fmt.Errorf("invalid time value %q for property %s, expected RFC3339 or a date", value, name)
+			}
+		}
+		return StdComparableTime(t), nil
+	default:
+		return StdComparableString(value), nil
+	}
+}
@@ -54,6 +54,25 @@ func (s StdComparableString) Contains(otherV ComparableValue) bool {
 	return strings.Contains(string(s), string(other))
 }
 
+// StdComparableBool is a wrapper for bool that implements ComparableValueInterface, false is smaller than true.
+type StdComparableBool bool
+
+// Compare compares two bools.
+func (b StdComparableBool) Compare(otherV ComparableValue) int {
+	other := otherV.(StdComparableBool)
+	if b == other {
+		return 0
+	} else if other {
+		return -1
+	}
+	return 1
+}
+
+// Contains checks if other is equal to self.
+func (b StdComparableBool) Contains(otherV ComparableValue) bool {
+	return b.Compare(otherV) == 0
+}
+
 // StdComparableRFC3339Timestamp takes RFC3339 Timestamp strings and compares them as TIMES. In case of time parsing error compares values as strings.
 type StdComparableRFC3339Timestamp string
 
@@ -0,0 +1,75 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package features defines the dashboard feature gates. Gates are set in the feature_gates section of the
+// dashboard config and may change at runtime, see config.FeatureEnabled.
+package features
+
+import (
+	"sort"
+
+	"k8s.io/component-base/featuregate"
+)
+
+const (
+	// PreciseScheduling enables the precise scheduling view of workloads,
+	// /api/v1/workloads/:namespace/:name/precise-scheduling.
+	PreciseScheduling featuregate.Feature = "PreciseScheduling"
+
+	// MetricsScraper enables the metrics API of the karmada-dashboard-metrics-scraper.
+	MetricsScraper featuregate.Feature = "MetricsScraper"
+)
+
+// defaultFeatureGates lists all dashboard features and their defaults.
+var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
+	PreciseScheduling: {Default: true, PreRelease: featuregate.Beta},
+	MetricsScraper:    {Default: true, PreRelease: featuregate.Beta},
+}
+
+// NewFeatureGate returns the dashboard feature gates with the given overrides of the defaults. Unknown
+// features are an error.
+func NewFeatureGate(overrides map[string]bool) (featuregate.FeatureGate, error) {
+	gate := featuregate.NewFeatureGate()
+	if err := gate.Add(defaultFeatureGates); err != nil {
+		return nil, err
+	}
+	if len(overrides) == 0 {
+		return gate, nil
+	}
+	if err := gate.SetFromMap(overrides); err != nil {
+		return nil, err
+	}
+	return gate, nil
+}
+
+// KnownFeatures returns the names of all dashboard features, sorted.
+func KnownFeatures() []string {
+	names := make([]string, 0, len(defaultFeatureGates))
+	for feature := range defaultFeatureGates {
+		names = append(names, string(feature))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Effective returns whether each dashboard feature is enabled in gate.
+func Effective(gate featuregate.FeatureGate) map[string]bool {
+	effective := make(map[string]bool, len(defaultFeatureGates))
+	for feature := range defaultFeatureGates {
+		effective[string(feature)] = gate.Enabled(feature)
+	}
+	return effective
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	"testing"
+)
+
+func TestNewFeatureGate(t *testing.T) {
+	gate, err := NewFeatureGate(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !gate.Enabled(PreciseScheduling) || !gate.Enabled(MetricsScraper) {
+		t.Errorf("expected all features to be enabled by default")
+	}
+
+	gate, err = NewFeatureGate(map[string]bool{string(PreciseScheduling): false})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	effective := Effective(gate)
+	if effective[string(PreciseScheduling)] || !effective[string(MetricsScraper)] {
+		t.Errorf("unexpected effective gates %v", effective)
+	}
+
+	if _, err := NewFeatureGate(map[string]bool{"Unknown": true}); err == nil {
+		t.Errorf("expected an error for an unknown feature")
+	}
+}
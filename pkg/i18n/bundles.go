@@ -0,0 +1,204 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i18n
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// bundleSuffix ends the file names of the bundles, the rest of the name is the locale, e.g. en-US.json.
+const bundleSuffix = ".json"
+
+// Bundle is the translations of a locale as served to the UI, a flat JSON object of translation keys.
+type Bundle struct {
+	Locale string
+	// Data contains the translations of the fallback locale for keys missing in the locale.
+	Data []byte
+	ETag string
+}
+
+// Bundles are the locale bundles found in a directory. They are reloaded when the files change, a
+// bundle which fails to parse keeps its last good version.
+type Bundles struct {
+	dir      string
+	fallback string
+
+	lock        sync.RWMutex
+	bundles     map[string]*Bundle
+	messages    map[string]map[string]string
+	fingerprint string
+}
+
+// NewBundles returns the bundles of dir, missing translations are taken from the fallback locale.
+func NewBundles(dir, fallback string) *Bundles {
+	return &Bundles{
+		dir:      dir,
+		fallback: fallback,
+		bundles:  make(map[string]*Bundle),
+		messages: make(map[string]map[string]string),
+	}
+}
+
+// Load reads the bundles of the directory. Bundles which can not be parsed are reported in the error,
+// the other bundles are loaded nonetheless.
+func (b *Bundles) Load() error {
+	files, fingerprint, err := b.scan()
+	if err != nil {
+		return err
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.load(files, fingerprint)
+}
+
+// Watch reloads the bundles every interval when files of the directory changed, until stopCh is closed.
+func (b *Bundles) Watch(interval time.Duration, stopCh <-chan struct{}) {
+	go wait.Until(func() {
+		files, fingerprint, err := b.scan()
+		if err != nil {
+			klog.ErrorS(err, "Failed to read i18n bundles", "dir", b.dir)
+			return
+		}
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		if fingerprint == b.fingerprint {
+			return
+		}
+		klog.InfoS("Reloading i18n bundles", "dir", b.dir)
+		if err := b.load(files, fingerprint); err != nil {
+			klog.ErrorS(err, "Failed to reload i18n bundles", "dir", b.dir)
+		}
+	}, interval, stopCh)
+}
+
+// scan lists the bundle files, the fingerprint changes whenever one of them does.
+func (b *Bundles) scan() ([]string, string, error) {
+	entries, err := os.ReadDir(b.dir)
+	if err != nil {
+		return nil, "", err
+	}
+	var files []string
+	var fingerprint strings.Builder
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), bundleSuffix) || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		// mounted ConfigMaps link their keys to a hidden directory, Stat follows the links
+		info, err := os.Stat(filepath.Join(b.dir, entry.Name()))
+		if err != nil || info.IsDir() {
+			continue
+		}
+		files = append(files, entry.Name())
+		fmt.Fprintf(&fingerprint, "%s:%d:%d;", entry.Name(), info.Size(), info.ModTime().UnixNano())
+	}
+	return files, fingerprint.String(), nil
+}
+
+func (b *Bundles) load(files []string, fingerprint string) error {
+	var errs []string
+	messages := make(map[string]map[string]string, len(files))
+	for _, name := range files {
+		locale := strings.TrimSuffix(name, bundleSuffix)
+		buff, err := os.ReadFile(filepath.Join(b.dir, name))
+		if err == nil {
+			var bundleMessages map[string]string
+			if err = json.Unmarshal(buff, &bundleMessages); err == nil {
+				messages[locale] = bundleMessages
+				continue
+			}
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", name, err))
+		if previous, ok := b.messages[locale]; ok {
+			messages[locale] = previous
+		}
+	}
+
+	bundles := make(map[string]*Bundle, len(messages))
+	for locale, bundleMessages := range messages {
+		merged := make(map[string]string, len(messages[b.fallback])+len(bundleMessages))
+		for key, message := range messages[b.fallback] {
+			merged[key] = message
+		}
+		for key, message := range bundleMessages {
+			merged[key] = message
+		}
+		data, err := json.Marshal(merged)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(data)
+		bundles[locale] = &Bundle{
+			Locale: locale,
+			Data:   data,
+			ETag:   fmt.Sprintf("%q", hex.EncodeToString(sum[:16])),
+		}
+	}
+	b.messages = messages
+	b.bundles = bundles
+	b.fingerprint = fingerprint
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("failed to parse i18n bundles: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// Locales returns the locales with a bundle, sorted.
+func (b *Bundles) Locales() []string {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.locales()
+}
+
+func (b *Bundles) locales() []string {
+	locales := make([]string, 0, len(b.bundles))
+	for locale := range b.bundles {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// Get returns the bundle best matching the preferred languages, see Negotiate. It returns false if no
+// bundle was loaded at all.
+func (b *Bundles) Get(preferred []string) (*Bundle, bool) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	locales := b.locales()
+	if len(locales) == 0 {
+		return nil, false
+	}
+	locale := Negotiate(preferred, locales, b.fallback)
+	if bundle, ok := b.bundles[locale]; ok {
+		return bundle, true
+	}
+	// the fallback locale has no bundle either, any bundle beats none
+	return b.bundles[locales[0]], true
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package i18n
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	got := ParseAcceptLanguage("en;q=0.5, zh-CN, fr;q=0, *;q=0.1, de;q=0.8")
+	if !reflect.DeepEqual(got, []string{"zh-CN", "de", "en"}) {
+		t.Errorf("unexpected languages %v", got)
+	}
+	if got := ParseAcceptLanguage(""); len(got) != 0 {
+		t.Errorf("expected no languages, got %v", got)
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	available := []string{"en-US", "zh-CN"}
+	for _, c := range []struct {
+		preferred []string
+		expected  string
+	}{
+		{[]string{"zh-cn"}, "zh-CN"},
+		{[]string{"zh-TW", "en"}, "zh-CN"},
+		{[]string{"fr", "en-GB"}, "en-US"},
+		{[]string{"fr"}, "en-US"},
+		{nil, "en-US"},
+	} {
+		if got := Negotiate(c.preferred, available, DefaultLocale); got != c.expected {
+			t.Errorf("Negotiate(%v) == %s, expected %s", c.preferred, got, c.expected)
+		}
+	}
+}
+
+func TestBundles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("en-US.json", `{"a": "A", "b": "B"}`)
+	write("zh-CN.json", `{"a": "甲"}`)
+	write("notes.txt", `ignored`)
+
+	bundles := NewBundles(dir, DefaultLocale)
+	if err := bundles.Load(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(bundles.Locales(), []string{"en-US", "zh-CN"}) {
+		t.Errorf("unexpected locales %v", bundles.Locales())
+	}
+	bundle, ok := bundles.Get([]string{"zh"})
+	if !ok || bundle.Locale != "zh-CN" {
+		t.Fatalf("expected the zh-CN bundle, got %+v", bundle)
+	}
+	var messages map[string]string
+	if err := json.Unmarshal(bundle.Data, &messages); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(messages, map[string]string{"a": "甲", "b": "B"}) {
+		t.Errorf("expected missing keys from the fallback locale, got %v", messages)
+	}
+	etag := bundle.ETag
+
+	write("zh-CN.json", `{"a": `)
+	if err := bundles.Load(); err == nil {
+		t.Errorf("expected an error for the broken bundle")
+	}
+	if bundle, _ := bundles.Get([]string{"zh-CN"}); bundle.ETag != etag {
+		t.Errorf("expected the last good bundle to stay in use")
+	}
+
+	write("zh-CN.json", `{"a": "乙"}`)
+	if err := bundles.Load(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bundle, _ := bundles.Get([]string{"zh-CN"}); bundle.ETag == etag {
+		t.Errorf("expected a new ETag for the changed bundle")
+	}
+
+	if _, ok := NewBundles(t.TempDir(), DefaultLocale).Get(nil); ok {
+		t.Errorf("expected no bundle for an empty directory")
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package i18n serves the locale bundles of the UI and negotiates the locale of requests.
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultLocale is the locale used when none of the requested locales is available.
+const DefaultLocale = "en-US"
+
+// ParseAcceptLanguage returns the languages of an Accept-Language header ordered by their quality,
+// languages with q=0 and the wildcard are dropped.
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		language string
+		quality  float64
+	}
+	var languages []weighted
+	for _, part := range strings.Split(header, ",") {
+		language, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		language = strings.TrimSpace(language)
+		if language == "" || language == "*" {
+			continue
+		}
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+		languages = append(languages, weighted{language: language, quality: quality})
+	}
+	sort.SliceStable(languages, func(i, j int) bool { return languages[i].quality > languages[j].quality })
+
+	result := make([]string, len(languages))
+	for i, language := range languages {
+		result[i] = language.language
+	}
+	return result
+}
+
+// Negotiate returns the available locale best matching the preferred languages, in order of preference.
+// A language matches a locale with the same tag, ignoring case, or else the first locale of the same
+// base language, e.g. zh-TW matches zh-CN. Without any match fallback is returned.
+func Negotiate(preferred []string, available []string, fallback string) string {
+	sorted := append([]string(nil), available...)
+	sort.Strings(sorted)
+	for _, language := range preferred {
+		language = strings.ReplaceAll(language, "_", "-")
+		for _, locale := range sorted {
+			if strings.EqualFold(language, locale) {
+				return locale
+			}
+		}
+		base := baseLanguage(language)
+		for _, locale := range sorted {
+			if strings.EqualFold(base, baseLanguage(locale)) {
+				return locale
+			}
+		}
+	}
+	return fallback
+}
+
+func baseLanguage(tag string) string {
+	base, _, _ := strings.Cut(tag, "-")
+	return base
+}
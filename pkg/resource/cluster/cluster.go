@@ -23,11 +23,14 @@ import (
 	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/karmada-io/dashboard/pkg/common/errors"
 	"github.com/karmada-io/dashboard/pkg/common/helpers"
 	"github.com/karmada-io/dashboard/pkg/common/types"
 	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
 )
 
 // Cluster the definition of a cluster.
@@ -51,7 +54,7 @@ type ClusterList struct {
 
 // GetClusterList returns a list of all Nodes in the cluster.
 func GetClusterList(client karmadaclientset.Interface, dsQuery *dataselect.DataSelectQuery) (*ClusterList, error) {
-	clusters, err := client.ClusterV1alpha1().Clusters().List(context.TODO(), helpers.ListEverything)
+	clusters, err := client.ClusterV1alpha1().Clusters().List(context.TODO(), dsQuery.FilterQuery.ListOptions())
 	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
 	if criticalError != nil {
 		return nil, criticalError
@@ -102,3 +105,16 @@ func getClusterConditionStatus(cluster *v1alpha1.Cluster, conditionType metav1.C
 	}
 	return metav1.ConditionUnknown
 }
+
+// WatchClusterList watches the clusters of the list, see common.ListWatch.
+func WatchClusterList(client karmadaclientset.Interface, dsQuery *dataselect.DataSelectQuery, resourceVersion string) (*common.ListWatch, error) {
+	return common.NewListWatch(resourceVersion, func(resourceVersion string) (watch.Interface, error) {
+		return client.ClusterV1alpha1().Clusters().Watch(context.TODO(), helpers.WatchFrom(resourceVersion))
+	}, func(object runtime.Object) (interface{}, bool) {
+		cluster, ok := object.(*v1alpha1.Cluster)
+		if !ok {
+			return nil, false
+		}
+		return toCluster(cluster), dsQuery.FilterQuery.Matches(ClusterCell(*cluster))
+	})
+}
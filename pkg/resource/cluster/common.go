@@ -18,6 +18,8 @@ package cluster
 
 import (
 	"github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 )
@@ -34,12 +36,24 @@ func (c ClusterCell) GetProperty(name dataselect.PropertyName) dataselect.Compar
 		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
 	case dataselect.NamespaceProperty:
 		return dataselect.StdComparableString(c.ObjectMeta.Namespace)
+	case dataselect.ReadyProperty:
+		return dataselect.StdComparableBool(meta.IsStatusConditionTrue(c.Status.Conditions, v1alpha1.ClusterConditionReady))
 	default:
 		// if name is not supported then just return a constant dummy value, sort will have no effect.
 		return nil
 	}
 }
 
+// GetLabels returns the labels of the cluster for label selectors.
+func (c ClusterCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the cluster for field selectors.
+func (c ClusterCell) GetFields() fields.Set {
+	return dataselect.ObjectMetaFields(c.ObjectMeta)
+}
+
 func toCells(std []v1alpha1.Cluster) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
@@ -21,11 +21,14 @@ import (
 
 	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/karmada-io/dashboard/pkg/common/errors"
 	"github.com/karmada-io/dashboard/pkg/common/helpers"
 	"github.com/karmada-io/dashboard/pkg/common/types"
 	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
 )
 
 // ClusterOverridePolicyList contains a list of overriders in the karmada control-plane.
@@ -50,7 +53,7 @@ type ClusterOverridePolicy struct {
 
 // GetClusterOverridePolicyList returns a list of all overiders in the karmada control-plance.
 func GetClusterOverridePolicyList(client karmadaclientset.Interface, dsQuery *dataselect.DataSelectQuery) (*ClusterOverridePolicyList, error) {
-	clusterOverridePolicies, err := client.PolicyV1alpha1().ClusterOverridePolicies().List(context.TODO(), helpers.ListEverything)
+	clusterOverridePolicies, err := client.PolicyV1alpha1().ClusterOverridePolicies().List(context.TODO(), dsQuery.FilterQuery.ListOptions())
 	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
 	if criticalError != nil {
 		return nil, criticalError
@@ -84,3 +87,16 @@ func toClusterOverridePolicy(overridepolicy *v1alpha1.ClusterOverridePolicy) Clu
 		OverrideRules:     overridepolicy.Spec.OverrideRules,
 	}
 }
+
+// WatchClusterOverridePolicyList watches the cluster override policies of the list, see common.ListWatch.
+func WatchClusterOverridePolicyList(client karmadaclientset.Interface, dsQuery *dataselect.DataSelectQuery, resourceVersion string) (*common.ListWatch, error) {
+	return common.NewListWatch(resourceVersion, func(resourceVersion string) (watch.Interface, error) {
+		return client.PolicyV1alpha1().ClusterOverridePolicies().Watch(context.TODO(), helpers.WatchFrom(resourceVersion))
+	}, func(object runtime.Object) (interface{}, bool) {
+		clusterOverridePolicy, ok := object.(*v1alpha1.ClusterOverridePolicy)
+		if !ok {
+			return nil, false
+		}
+		return toClusterOverridePolicy(clusterOverridePolicy), dsQuery.FilterQuery.Matches(ClusterOverridePolicyCell(*clusterOverridePolicy))
+	})
+}
@@ -18,6 +18,7 @@ package clusterpropagationpolicy
 
 import (
 	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 )
@@ -38,6 +39,16 @@ func (c ClusterPropagationPolicyCell) GetProperty(name dataselect.PropertyName)
 	}
 }
 
+// GetLabels returns the labels of the cluster propagation policy for label selectors.
+func (c ClusterPropagationPolicyCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the cluster propagation policy for field selectors.
+func (c ClusterPropagationPolicyCell) GetFields() fields.Set {
+	return dataselect.ObjectMetaFields(c.ObjectMeta)
+}
+
 func toCells(std []v1alpha1.ClusterPropagationPolicy) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
@@ -21,11 +21,14 @@ import (
 
 	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/karmada-io/dashboard/pkg/common/errors"
 	"github.com/karmada-io/dashboard/pkg/common/helpers"
 	"github.com/karmada-io/dashboard/pkg/common/types"
 	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
 )
 
 // ClusterPropagationPolicyList contains a list of propagation in the karmada control-plane.
@@ -50,7 +53,7 @@ type ClusterPropagationPolicy struct {
 
 // GetClusterPropagationPolicyList returns a list of all propagations in the karmada control-plance.
 func GetClusterPropagationPolicyList(client karmadaclientset.Interface, dsQuery *dataselect.DataSelectQuery) (*ClusterPropagationPolicyList, error) {
-	clusterPropagationPolicies, err := client.PolicyV1alpha1().ClusterPropagationPolicies().List(context.TODO(), helpers.ListEverything)
+	clusterPropagationPolicies, err := client.PolicyV1alpha1().ClusterPropagationPolicies().List(context.TODO(), dsQuery.FilterQuery.ListOptions())
 	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
 	if criticalError != nil {
 		return nil, criticalError
@@ -84,3 +87,16 @@ func toClusterPropagationPolicy(propagationpolicy *v1alpha1.ClusterPropagationPo
 		ClusterAffinity: propagationpolicy.Spec.Placement.ClusterAffinity,
 	}
 }
+
+// WatchClusterPropagationPolicyList watches the cluster propagation policies of the list, see common.ListWatch.
+func WatchClusterPropagationPolicyList(client karmadaclientset.Interface, dsQuery *dataselect.DataSelectQuery, resourceVersion string) (*common.ListWatch, error) {
+	return common.NewListWatch(resourceVersion, func(resourceVersion string) (watch.Interface, error) {
+		return client.PolicyV1alpha1().ClusterPropagationPolicies().Watch(context.TODO(), helpers.WatchFrom(resourceVersion))
+	}, func(object runtime.Object) (interface{}, bool) {
+		clusterPropagationPolicy, ok := object.(*v1alpha1.ClusterPropagationPolicy)
+		if !ok {
+			return nil, false
+		}
+		return toClusterPropagationPolicy(clusterPropagationPolicy), dsQuery.FilterQuery.Matches(ClusterPropagationPolicyCell(*clusterPropagationPolicy))
+	})
+}
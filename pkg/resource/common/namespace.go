@@ -14,7 +14,11 @@
 
 package common
 
-import api "k8s.io/api/core/v1"
+import (
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+)
 
 // NamespaceQuery is a query for namespaces of a list of objects.
 // There's three cases:
@@ -22,18 +26,59 @@ import api "k8s.io/api/core/v1"
 //  2. Single namespace selected: this allows for optimizations when querying backends
 //  3. More than one namespace selected: resources from all namespaces are queried and then
 //     filtered here.
+//
+// Queries restricted to the namespaces a tenant can see never match more than these namespaces,
+// an empty restricted query matches nothing.
 type NamespaceQuery struct {
 	namespaces []string
+	restricted bool
 }
 
 // NewSameNamespaceQuery creates new namespace query that queries single namespace.
 func NewSameNamespaceQuery(namespace string) *NamespaceQuery {
-	return &NamespaceQuery{[]string{namespace}}
+	return &NamespaceQuery{namespaces: []string{namespace}}
 }
 
 // NewNamespaceQuery creates new query for given namespaces.
 func NewNamespaceQuery(namespaces []string) *NamespaceQuery {
-	return &NamespaceQuery{namespaces}
+	return &NamespaceQuery{namespaces: namespaces}
+}
+
+// Restrict limits the query to the given namespaces. A query for all namespaces becomes a query
+// for the allowed ones.
+func (n *NamespaceQuery) Restrict(allowed []string) *NamespaceQuery {
+	if len(n.namespaces) == 0 {
+		return &NamespaceQuery{namespaces: allowed, restricted: true}
+	}
+	namespaces := make([]string, 0, len(n.namespaces))
+	for _, namespace := range n.namespaces {
+		for _, allowedNamespace := range allowed {
+			if namespace == allowedNamespace {
+				namespaces = append(namespaces, namespace)
+				break
+			}
+		}
+	}
+	return &NamespaceQuery{namespaces: namespaces, restricted: true}
+}
+
+// Namespaces returns the namespaces of the query, empty for all namespaces of an unrestricted query.
+func (n *NamespaceQuery) Namespaces() []string {
+	return n.namespaces
+}
+
+// Restricted returns true when the query is limited to the namespaces a tenant can see.
+func (n *NamespaceQuery) Restricted() bool {
+	return n.restricted
+}
+
+// IsSingleRequest returns true when ListInNamespaces lists the query with one request and keeps all
+// the objects returned, so that the API server can paginate the list.
+func (n *NamespaceQuery) IsSingleRequest() bool {
+	if len(n.namespaces) == 0 {
+		return !n.restricted
+	}
+	return len(n.namespaces) == 1
 }
 
 // ToRequestParam returns K8s API namespace query for list of objects from this namespaces.
@@ -49,7 +94,7 @@ func (n *NamespaceQuery) ToRequestParam() string {
 // Matches returns true when the given namespace matches this query.
 func (n *NamespaceQuery) Matches(namespace string) bool {
 	if len(n.namespaces) == 0 {
-		return true
+		return !n.restricted
 	}
 
 	for _, queryNamespace := range n.namespaces {
@@ -59,3 +104,63 @@ func (n *NamespaceQuery) Matches(namespace string) bool {
 	}
 	return false
 }
+
+// ListInNamespaces lists the objects of the namespaces of the query and drops the ones the query does
+// not match. Restricted queries of several namespaces need one request per namespace, as tenants are
+// usually not allowed to list across namespaces, all other queries need a single request. The first
+// error is returned along with the objects of the namespaces which could be listed.
+func ListInNamespaces[T any, L interface {
+	*T
+	runtime.Object
+}](nsQuery *NamespaceQuery, list func(namespace string) (L, error)) (L, error) {
+	if !nsQuery.restricted || len(nsQuery.namespaces) == 1 {
+		result, err := list(nsQuery.ToRequestParam())
+		if err != nil {
+			return result, err
+		}
+		return result, filterList(nsQuery, result)
+	}
+
+	result := L(new(T))
+	var items []runtime.Object
+	var firstErr error
+	for _, namespace := range nsQuery.namespaces {
+		namespaceList, err := list(namespace)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		namespaceItems, err := meta.ExtractList(namespaceList)
+		if err != nil {
+			return result, err
+		}
+		items = append(items, namespaceItems...)
+	}
+	if err := meta.SetList(result, items); err != nil {
+		return result, err
+	}
+	return result, firstErr
+}
+
+func filterList(nsQuery *NamespaceQuery, list runtime.Object) error {
+	if len(nsQuery.namespaces) <= 1 && !nsQuery.restricted {
+		return nil
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	filtered := make([]runtime.Object, 0, len(items))
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			return err
+		}
+		if nsQuery.Matches(accessor.GetNamespace()) {
+			filtered = append(filtered, item)
+		}
+	}
+	return meta.SetList(list, filtered)
+}
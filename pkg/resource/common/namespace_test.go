@@ -0,0 +1,93 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNamespaceQueryRestrict(t *testing.T) {
+	all := NewNamespaceQuery(nil).Restrict([]string{"a", "b"})
+	if !reflect.DeepEqual(all.Namespaces(), []string{"a", "b"}) || !all.Matches("a") || all.Matches("c") {
+		t.Errorf("unexpected restriction of all namespaces: %v", all.Namespaces())
+	}
+
+	selected := NewNamespaceQuery([]string{"b", "c"}).Restrict([]string{"a", "b"})
+	if !reflect.DeepEqual(selected.Namespaces(), []string{"b"}) {
+		t.Errorf("expected only the allowed selected namespace, got %v", selected.Namespaces())
+	}
+
+	none := NewNamespaceQuery(nil).Restrict(nil)
+	if none.Matches("a") {
+		t.Errorf("expected an empty restricted query to match nothing")
+	}
+	if !NewNamespaceQuery(nil).Matches("a") {
+		t.Errorf("expected an unrestricted query to match all namespaces")
+	}
+}
+
+func TestNamespaceQueryIsSingleRequest(t *testing.T) {
+	if !NewNamespaceQuery(nil).IsSingleRequest() || !NewNamespaceQuery(nil).Restrict([]string{"a"}).IsSingleRequest() {
+		t.Errorf("expected all namespaces and a single namespace to be listed with one request")
+	}
+	if NewNamespaceQuery([]string{"a", "b"}).IsSingleRequest() || NewNamespaceQuery(nil).Restrict([]string{"a", "b"}).IsSingleRequest() {
+		t.Errorf("expected several namespaces to be filtered or listed with several requests")
+	}
+}
+
+func TestListInNamespaces(t *testing.T) {
+	configMaps := map[string][]string{"a": {"a1", "a2"}, "b": {"b1"}, "c": {"c1"}}
+	var requested []string
+	list := func(namespace string) (*v1.ConfigMapList, error) {
+		requested = append(requested, namespace)
+		result := &v1.ConfigMapList{}
+		for ns, names := range configMaps {
+			if namespace != "" && namespace != ns {
+				continue
+			}
+			for _, name := range names {
+				result.Items = append(result.Items, v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name}})
+			}
+		}
+		if namespace == "b" {
+			return nil, errors.New("forbidden")
+		}
+		return result, nil
+	}
+
+	result, err := ListInNamespaces(NewNamespaceQuery(nil).Restrict([]string{"a", "b"}), list)
+	if err == nil {
+		t.Errorf("expected the error of namespace b")
+	}
+	if !reflect.DeepEqual(requested, []string{"a", "b"}) || len(result.Items) != 2 {
+		t.Errorf("expected one request per namespace and the items of a, got %v and %d items", requested, len(result.Items))
+	}
+
+	requested = nil
+	result, err = ListInNamespaces(NewNamespaceQuery([]string{"a", "c"}), list)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(requested, []string{""}) || len(result.Items) != 3 {
+		t.Errorf("expected a single request filtered to a and c, got %v and %d items", requested, len(result.Items))
+	}
+}
@@ -155,8 +155,9 @@ func GetReplicaSetListChannelWithOptions(client client.Interface, nsQuery *Names
 	}
 
 	go func() {
-		list, err := client.AppsV1().ReplicaSets(nsQuery.ToRequestParam()).
-			List(context.TODO(), options)
+		list, err := ListInNamespaces(nsQuery, func(namespace string) (*apps.ReplicaSetList, error) {
+			return client.AppsV1().ReplicaSets(namespace).List(context.TODO(), options)
+		})
 		var filteredItems []apps.ReplicaSet
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -183,14 +184,21 @@ type DeploymentListChannel struct {
 // that both must be read numReads times.
 func GetDeploymentListChannel(client client.Interface,
 	nsQuery *NamespaceQuery, numReads int) DeploymentListChannel {
+	return GetDeploymentListChannelWithOptions(client, nsQuery, helpers.ListEverything, numReads)
+}
+
+// GetDeploymentListChannelWithOptions is GetDeploymentListChannel plus listing options.
+func GetDeploymentListChannelWithOptions(client client.Interface,
+	nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) DeploymentListChannel {
 	channel := DeploymentListChannel{
 		List:  make(chan *apps.DeploymentList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.AppsV1().Deployments(nsQuery.ToRequestParam()).
-			List(context.TODO(), helpers.ListEverything)
+		list, err := ListInNamespaces(nsQuery, func(namespace string) (*apps.DeploymentList, error) {
+			return client.AppsV1().Deployments(namespace).List(context.TODO(), options)
+		})
 		var filteredItems []apps.Deployment
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -216,13 +224,21 @@ type DaemonSetListChannel struct {
 // GetDaemonSetListChannel returns a pair of channels to a DaemonSet list and errors that both must be read
 // numReads times.
 func GetDaemonSetListChannel(client client.Interface, nsQuery *NamespaceQuery, numReads int) DaemonSetListChannel {
+	return GetDaemonSetListChannelWithOptions(client, nsQuery, helpers.ListEverything, numReads)
+}
+
+// GetDaemonSetListChannelWithOptions is GetDaemonSetListChannel plus listing options.
+func GetDaemonSetListChannelWithOptions(client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) DaemonSetListChannel {
 	channel := DaemonSetListChannel{
 		List:  make(chan *apps.DaemonSetList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.AppsV1().DaemonSets(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+		list, err := ListInNamespaces(nsQuery, func(namespace string) (*apps.DaemonSetList, error) {
+			return client.AppsV1().DaemonSets(namespace).List(context.TODO(), options)
+		})
 		var filteredItems []apps.DaemonSet
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -248,13 +264,21 @@ type JobListChannel struct {
 // GetJobListChannel returns a pair of channels to a Job list and errors that both must be read numReads times.
 func GetJobListChannel(client client.Interface,
 	nsQuery *NamespaceQuery, numReads int) JobListChannel {
+	return GetJobListChannelWithOptions(client, nsQuery, helpers.ListEverything, numReads)
+}
+
+// GetJobListChannelWithOptions is GetJobListChannel plus listing options.
+func GetJobListChannelWithOptions(client client.Interface,
+	nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) JobListChannel {
 	channel := JobListChannel{
 		List:  make(chan *batch.JobList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.BatchV1().Jobs(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+		list, err := ListInNamespaces(nsQuery, func(namespace string) (*batch.JobList, error) {
+			return client.BatchV1().Jobs(namespace).List(context.TODO(), options)
+		})
 		var filteredItems []batch.Job
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -279,13 +303,21 @@ type CronJobListChannel struct {
 
 // GetCronJobListChannel returns a pair of channels to a Cron Job list and errors that both must be read numReads times.
 func GetCronJobListChannel(client client.Interface, nsQuery *NamespaceQuery, numReads int) CronJobListChannel {
+	return GetCronJobListChannelWithOptions(client, nsQuery, helpers.ListEverything, numReads)
+}
+
+// GetCronJobListChannelWithOptions is GetCronJobListChannel plus listing options.
+func GetCronJobListChannelWithOptions(client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) CronJobListChannel {
 	channel := CronJobListChannel{
 		List:  make(chan *batch.CronJobList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.BatchV1().CronJobs(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+		list, err := ListInNamespaces(nsQuery, func(namespace string) (*batch.CronJobList, error) {
+			return client.BatchV1().CronJobs(namespace).List(context.TODO(), options)
+		})
 		var filteredItems []batch.CronJob
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -312,12 +344,20 @@ type ServiceListChannel struct {
 // must be read numReads times.
 func GetServiceListChannel(client client.Interface, nsQuery *NamespaceQuery,
 	numReads int) ServiceListChannel {
+	return GetServiceListChannelWithOptions(client, nsQuery, helpers.ListEverything, numReads)
+}
+
+// GetServiceListChannelWithOptions is GetServiceListChannel plus listing options.
+func GetServiceListChannelWithOptions(client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) ServiceListChannel {
 	channel := ServiceListChannel{
 		List:  make(chan *v1.ServiceList, numReads),
 		Error: make(chan error, numReads),
 	}
 	go func() {
-		list, err := client.CoreV1().Services(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+		list, err := ListInNamespaces(nsQuery, func(namespace string) (*v1.ServiceList, error) {
+			return client.CoreV1().Services(namespace).List(context.TODO(), options)
+		})
 		var filteredItems []v1.Service
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -349,7 +389,9 @@ func GetEndpointListChannelWithOptions(client client.Interface,
 	}
 
 	go func() {
-		list, err := client.CoreV1().Endpoints(nsQuery.ToRequestParam()).List(context.TODO(), opt)
+		list, err := ListInNamespaces(nsQuery, func(namespace string) (*v1.EndpointsList, error) {
+			return client.CoreV1().Endpoints(namespace).List(context.TODO(), opt)
+		})
 
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
@@ -388,7 +430,9 @@ func GetPodListChannelWithOptions(client client.Interface, nsQuery *NamespaceQue
 	}
 
 	go func() {
-		list, err := client.CoreV1().Pods(nsQuery.ToRequestParam()).List(context.TODO(), options)
+		list, err := ListInNamespaces(nsQuery, func(namespace string) (*v1.PodList, error) {
+			return client.CoreV1().Pods(namespace).List(context.TODO(), options)
+		})
 		var filteredItems []v1.Pod
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -427,7 +471,9 @@ func GetEventListChannelWithOptions(client client.Interface,
 	}
 
 	go func() {
-		list, err := client.CoreV1().Events(nsQuery.ToRequestParam()).List(context.TODO(), options)
+		list, err := ListInNamespaces(nsQuery, func(namespace string) (*v1.EventList, error) {
+			return client.CoreV1().Events(namespace).List(context.TODO(), options)
+		})
 		var filteredItems []v1.Event
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -459,13 +505,18 @@ type NodeListChannel struct {
 // GetNodeListChannel returns a pair of channels to a Node list and errors that both must be read
 // numReads times.
 func GetNodeListChannel(client client.Interface, numReads int) NodeListChannel {
+	return GetNodeListChannelWithOptions(client, helpers.ListEverything, numReads)
+}
+
+// GetNodeListChannelWithOptions is GetNodeListChannel plus listing options.
+func GetNodeListChannelWithOptions(client client.Interface, options metaV1.ListOptions, numReads int) NodeListChannel {
 	channel := NodeListChannel{
 		List:  make(chan *v1.NodeList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.CoreV1().Nodes().List(context.TODO(), helpers.ListEverything)
+		list, err := client.CoreV1().Nodes().List(context.TODO(), options)
 		for i := 0; i < numReads; i++ {
 			channel.List <- list
 			channel.Error <- err
@@ -491,13 +542,21 @@ type StatefulSetListChannel struct {
 // numReads times.
 func GetStatefulSetListChannel(client client.Interface,
 	nsQuery *NamespaceQuery, numReads int) StatefulSetListChannel {
+	return GetStatefulSetListChannelWithOptions(client, nsQuery, helpers.ListEverything, numReads)
+}
+
+// GetStatefulSetListChannelWithOptions is GetStatefulSetListChannel plus listing options.
+func GetStatefulSetListChannelWithOptions(client client.Interface,
+	nsQuery *NamespaceQuery, options metaV1.ListOptions, numReads int) StatefulSetListChannel {
 	channel := StatefulSetListChannel{
 		List:  make(chan *apps.StatefulSetList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		statefulSets, err := client.AppsV1().StatefulSets(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+		statefulSets, err := ListInNamespaces(nsQuery, func(namespace string) (*apps.StatefulSetList, error) {
+			return client.AppsV1().StatefulSets(namespace).List(context.TODO(), options)
+		})
 		var filteredItems []apps.StatefulSet
 		for _, item := range statefulSets.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -524,13 +583,21 @@ type ConfigMapListChannel struct {
 // numReads times.
 func GetConfigMapListChannel(client client.Interface, nsQuery *NamespaceQuery,
 	numReads int) ConfigMapListChannel {
+	return GetConfigMapListChannelWithOptions(client, nsQuery, helpers.ListEverything, numReads)
+}
+
+// GetConfigMapListChannelWithOptions is GetConfigMapListChannel plus listing options.
+func GetConfigMapListChannelWithOptions(client client.Interface, nsQuery *NamespaceQuery,
+	options metaV1.ListOptions, numReads int) ConfigMapListChannel {
 	channel := ConfigMapListChannel{
 		List:  make(chan *v1.ConfigMapList, numReads),
 		Error: make(chan error, numReads),
 	}
 
 	go func() {
-		list, err := client.CoreV1().ConfigMaps(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+		list, err := ListInNamespaces(nsQuery, func(namespace string) (*v1.ConfigMapList, error) {
+			return client.CoreV1().ConfigMaps(namespace).List(context.TODO(), options)
+		})
 		var filteredItems []v1.ConfigMap
 		for _, item := range list.Items {
 			if nsQuery.Matches(item.ObjectMeta.Namespace) {
@@ -0,0 +1,355 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// WatchEventReset tells clients to drop what they know about the list, the ADDED events of all
+// objects follow. It is sent when the watch can not be resumed, e.g. the resource version expired.
+const WatchEventReset watch.EventType = "RESET"
+
+// minWatchDuration keeps watches which end right away from being reopened in a tight loop.
+const minWatchDuration = time.Second
+
+const (
+	// watchBatchWindow is how long events are collected after the first one of a batch.
+	watchBatchWindow = 100 * time.Millisecond
+	// maxWatchBatch limits the number of events of a batch.
+	maxWatchBatch = 500
+)
+
+// WatchEvent is a change of a list, the object is in the presentation type of the list items.
+type WatchEvent struct {
+	Type watch.EventType
+	// ResourceVersion is the version to resume the watch from after this event, empty if the
+	// watch can not be resumed from here.
+	ResourceVersion string
+	Object          interface{}
+	// Error is set for ERROR events, which end the watch.
+	Error error
+}
+
+// ListWatchFunc opens a watch from resourceVersion.
+type ListWatchFunc func(resourceVersion string) (watch.Interface, error)
+
+// ConvertFunc turns an object into the presentation type of the list items and tells whether the
+// filters of the list match it.
+type ConvertFunc func(object runtime.Object) (item interface{}, matches bool)
+
+// BatchConvertFunc returns the ConvertFunc of a batch of events with the given objects, so that what the
+// conversion needs, e.g. the pods of deployments, can be read once for the whole batch.
+type BatchConvertFunc func(objects []runtime.Object) ConvertFunc
+
+// ListWatch streams the changes of a list page. Objects that stop matching the filters of the list
+// are sent as DELETED, ended watches are reopened from the last resource version.
+type ListWatch struct {
+	watchFn  ListWatchFunc
+	convert  BatchConvertFunc
+	result   chan WatchEvent
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// NewListWatch opens the watch before it returns, so that errors like a forbidden watch can be
+// reported as failed request. An empty resourceVersion starts with the ADDED events of all objects.
+func NewListWatch(resourceVersion string, watchFn ListWatchFunc, convert ConvertFunc) (*ListWatch, error) {
+	return NewBatchListWatch(resourceVersion, watchFn, func([]runtime.Object) ConvertFunc {
+		return convert
+	})
+}
+
+// NewBatchListWatch is NewListWatch for conversions reading other resources. Events arriving together
+// are converted in batches.
+func NewBatchListWatch(resourceVersion string, watchFn ListWatchFunc, convert BatchConvertFunc) (*ListWatch, error) {
+	watcher, err := watchFn(resourceVersion)
+	if err != nil {
+		return nil, err
+	}
+	w := &ListWatch{
+		watchFn: watchFn,
+		convert: convert,
+		result:  make(chan WatchEvent),
+		stopCh:  make(chan struct{}),
+	}
+	go w.run(watcher, resourceVersion)
+	return w, nil
+}
+
+// ResultChan returns the events of the list, it is closed when the watch ends.
+func (w *ListWatch) ResultChan() <-chan WatchEvent {
+	return w.result
+}
+
+// Stop ends the watch.
+func (w *ListWatch) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stopCh)
+	})
+}
+
+func (w *ListWatch) run(watcher watch.Interface, resourceVersion string) {
+	defer close(w.result)
+	// the initial ADDED events are not ordered by resource version, the watch is only resumable
+	// once it was started from a resource version or a bookmark was seen
+	lastResourceVersion := resourceVersion
+	for {
+		if _, merged := watcher.(*mergedWatch); merged {
+			// the namespaces of a merged watch progress independently, the resource version of an
+			// event does not cover the others, so it is relisted instead of resumed
+			lastResourceVersion = ""
+		}
+		opened := time.Now()
+		expired, done := w.forward(watcher, &lastResourceVersion)
+		watcher.Stop()
+		if done {
+			return
+		}
+		select {
+		case <-time.After(minWatchDuration - time.Since(opened)):
+		case <-w.stopCh:
+			return
+		}
+		if expired || lastResourceVersion == "" {
+			lastResourceVersion = ""
+			if !w.send(WatchEvent{Type: WatchEventReset}) {
+				return
+			}
+		}
+		var err error
+		watcher, err = w.watchFn(lastResourceVersion)
+		if err != nil {
+			w.send(WatchEvent{Type: watch.Error, Error: err})
+			return
+		}
+	}
+}
+
+// forward sends the events of watcher until it ends. It returns whether it ended because the resource
+// version expired and whether the list watch is done.
+func (w *ListWatch) forward(watcher watch.Interface, lastResourceVersion *string) (expired, done bool) {
+	for {
+		events, open, done := w.receive(watcher)
+		if done {
+			return false, true
+		}
+		var objects []runtime.Object
+		for _, event := range events {
+			if event.Type == watch.Added || event.Type == watch.Modified || event.Type == watch.Deleted {
+				objects = append(objects, event.Object)
+			}
+		}
+		var convert ConvertFunc
+		if len(objects) > 0 {
+			convert = w.convert(objects)
+		}
+
+		for _, event := range events {
+			switch event.Type {
+			case watch.Error:
+				err := apierrors.FromObject(event.Object)
+				if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+					return true, false
+				}
+				w.send(WatchEvent{Type: watch.Error, Error: err})
+				return false, true
+			case watch.Bookmark:
+				*lastResourceVersion = resourceVersionOf(event.Object)
+				if !w.send(WatchEvent{Type: watch.Bookmark, ResourceVersion: *lastResourceVersion}) {
+					return false, true
+				}
+			case watch.Added, watch.Modified, watch.Deleted:
+				if *lastResourceVersion != "" {
+					*lastResourceVersion = resourceVersionOf(event.Object)
+				}
+				item, matches := convert(event.Object)
+				if item == nil {
+					continue
+				}
+				eventType := event.Type
+				if !matches {
+					if eventType == watch.Added {
+						continue
+					}
+					// the object may have matched before
+					eventType = watch.Deleted
+				}
+				if !w.send(WatchEvent{Type: eventType, ResourceVersion: *lastResourceVersion, Object: item}) {
+					return false, true
+				}
+			}
+		}
+		if !open {
+			return false, false
+		}
+	}
+}
+
+// receive waits for the next event of watcher and collects the events following it within the batch
+// window. open is false if watcher ended, done is true if the list watch was stopped.
+func (w *ListWatch) receive(watcher watch.Interface) (events []watch.Event, open, done bool) {
+	select {
+	case <-w.stopCh:
+		return nil, false, true
+	case event, ok := <-watcher.ResultChan():
+		if !ok {
+			return nil, false, false
+		}
+		events = append(events, event)
+	}
+	window := time.NewTimer(watchBatchWindow)
+	defer window.Stop()
+	for len(events) < maxWatchBatch {
+		select {
+		case <-w.stopCh:
+			return nil, false, true
+		case <-window.C:
+			return events, true, false
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return events, false, false
+			}
+			events = append(events, event)
+			if event.Type == watch.Error {
+				// the watch ends with the error
+				return events, true, false
+			}
+		}
+	}
+	return events, true, false
+}
+
+func (w *ListWatch) send(event WatchEvent) bool {
+	select {
+	case w.result <- event:
+		return true
+	case <-w.stopCh:
+		return false
+	}
+}
+
+func resourceVersionOf(object runtime.Object) string {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return ""
+	}
+	return accessor.GetResourceVersion()
+}
+
+// WatchInNamespaces watches the objects of the namespaces of the query like ListInNamespaces lists them.
+// Restricted queries of several namespaces open one watch per namespace, their events are merged and
+// they end together. Bookmarks are dropped from merged watches, as the resource version of one
+// namespace does not cover the events still on their way from the others, and ListWatch relists
+// them instead of resuming them.
+func WatchInNamespaces(nsQuery *NamespaceQuery, watchFn func(namespace string) (watch.Interface, error)) (watch.Interface, error) {
+	if !nsQuery.restricted || len(nsQuery.namespaces) == 1 {
+		watcher, err := watchFn(nsQuery.ToRequestParam())
+		if err != nil {
+			return nil, err
+		}
+		if len(nsQuery.namespaces) <= 1 && !nsQuery.restricted {
+			return watcher, nil
+		}
+		return watch.Filter(watcher, func(event watch.Event) (watch.Event, bool) {
+			return event, matchesEvent(nsQuery, event)
+		}), nil
+	}
+
+	if len(nsQuery.namespaces) == 0 {
+		// an empty restricted query matches nothing
+		return watch.NewFake(), nil
+	}
+	watchers := make([]watch.Interface, 0, len(nsQuery.namespaces))
+	for _, namespace := range nsQuery.namespaces {
+		watcher, err := watchFn(namespace)
+		if err != nil {
+			for _, started := range watchers {
+				started.Stop()
+			}
+			return nil, err
+		}
+		watchers = append(watchers, watcher)
+	}
+	return newMergedWatch(watchers), nil
+}
+
+func matchesEvent(nsQuery *NamespaceQuery, event watch.Event) bool {
+	if event.Type == watch.Bookmark || event.Type == watch.Error {
+		return true
+	}
+	accessor, err := meta.Accessor(event.Object)
+	return err == nil && nsQuery.Matches(accessor.GetNamespace())
+}
+
+// mergedWatch merges the events of several watches, it ends when the first of them ends.
+type mergedWatch struct {
+	watchers []watch.Interface
+	result   chan watch.Event
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+func newMergedWatch(watchers []watch.Interface) *mergedWatch {
+	m := &mergedWatch{
+		watchers: watchers,
+		result:   make(chan watch.Event),
+		stopCh:   make(chan struct{}),
+	}
+	var wg sync.WaitGroup
+	for _, watcher := range watchers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer m.Stop()
+			for event := range watcher.ResultChan() {
+				if event.Type == watch.Bookmark {
+					continue
+				}
+				select {
+				case m.result <- event:
+				case <-m.stopCh:
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(m.result)
+	}()
+	return m
+}
+
+func (m *mergedWatch) ResultChan() <-chan watch.Event {
+	return m.result
+}
+
+func (m *mergedWatch) Stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopCh)
+		for _, watcher := range m.watchers {
+			watcher.Stop()
+		}
+	})
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func TestListWatch(t *testing.T) {
+	reopened := make(chan string, 1)
+	watchFn := func(resourceVersion string) (watch.Interface, error) {
+		reopened <- resourceVersion
+		return watch.NewFake(), nil
+	}
+	convert := func(object runtime.Object) (interface{}, bool) {
+		configMap := object.(*v1.ConfigMap)
+		return configMap.Name, configMap.Labels["app"] == "x"
+	}
+	configMap := func(name, resourceVersion string, matches bool) *v1.ConfigMap {
+		labels := map[string]string{}
+		if matches {
+			labels["app"] = "x"
+		}
+		return &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, ResourceVersion: resourceVersion, Labels: labels}}
+	}
+
+	watcher := watch.NewFake()
+	opened := false
+	listWatch, err := NewListWatch("", func(resourceVersion string) (watch.Interface, error) {
+		if !opened {
+			opened = true
+			return watcher, nil
+		}
+		return watchFn(resourceVersion)
+	}, convert)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer listWatch.Stop()
+	go func() {
+		watcher.Add(configMap("a", "3", true))
+		watcher.Add(configMap("b", "2", false))
+		watcher.Modify(configMap("a", "5", false))
+		watcher.Action(watch.Bookmark, configMap("", "10", false))
+		watcher.Modify(configMap("a", "11", true))
+		watcher.Error(&metav1.Status{Status: metav1.StatusFailure, Code: http.StatusGone, Reason: metav1.StatusReasonExpired})
+	}()
+
+	expected := []WatchEvent{
+		{Type: watch.Added, Object: "a"},
+		{Type: watch.Deleted, Object: "a"},
+		{Type: watch.Bookmark, ResourceVersion: "10"},
+		{Type: watch.Modified, ResourceVersion: "11", Object: "a"},
+		{Type: WatchEventReset},
+	}
+	for i, want := range expected {
+		got := <-listWatch.ResultChan()
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("event %d is %+v, expected %+v", i, got, want)
+		}
+	}
+	if resourceVersion := <-reopened; resourceVersion != "" {
+		t.Errorf("expected the expired watch to be reopened from scratch, got %q", resourceVersion)
+	}
+}
+
+func TestWatchInNamespaces(t *testing.T) {
+	watchers := map[string]*watch.FakeWatcher{}
+	watchFn := func(namespace string) (watch.Interface, error) {
+		watchers[namespace] = watch.NewFake()
+		return watchers[namespace], nil
+	}
+
+	watcher, err := WatchInNamespaces(NewNamespaceQuery(nil).Restrict([]string{"a", "b"}), watchFn)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(watchers) != 2 {
+		t.Fatalf("expected one watch per namespace, got %v", watchers)
+	}
+	go watchers["b"].Add(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "b", Name: "b1"}})
+	if event := <-watcher.ResultChan(); event.Type != watch.Added {
+		t.Errorf("expected the event of namespace b, got %v", event)
+	}
+	watchers["a"].Stop()
+	if _, ok := <-watcher.ResultChan(); ok {
+		t.Errorf("expected the merged watch to end with the watch of namespace a")
+	}
+
+	watchers = map[string]*watch.FakeWatcher{}
+	watcher, err = WatchInNamespaces(NewNamespaceQuery([]string{"a", "c"}), watchFn)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	go func() {
+		watchers[""].Add(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "b", Name: "b1"}})
+		watchers[""].Add(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "c", Name: "c1"}})
+	}()
+	if event := <-watcher.ResultChan(); event.Object.(*v1.ConfigMap).Name != "c1" {
+		t.Errorf("expected the event of namespace b to be filtered, got %v", event)
+	}
+	watcher.Stop()
+}
+
+func TestListWatchBatches(t *testing.T) {
+	watcher := watch.NewFake()
+	var batches [][]string
+	listWatch, err := NewBatchListWatch("", func(string) (watch.Interface, error) {
+		return watcher, nil
+	}, func(objects []runtime.Object) ConvertFunc {
+		var names []string
+		for _, object := range objects {
+			names = append(names, object.(*v1.ConfigMap).Name)
+		}
+		batches = append(batches, names)
+		return func(object runtime.Object) (interface{}, bool) {
+			return object.(*v1.ConfigMap).Name, true
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer listWatch.Stop()
+	go func() {
+		watcher.Add(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "a"}})
+		watcher.Add(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "b"}})
+	}()
+
+	for _, want := range []string{"a", "b"} {
+		if got := <-listWatch.ResultChan(); got.Object != want {
+			t.Fatalf("expected the event of %s, got %+v", want, got)
+		}
+	}
+	if !reflect.DeepEqual(batches, [][]string{{"a", "b"}}) {
+		t.Errorf("expected the events to be converted in one batch, got %v", batches)
+	}
+}
+
+func TestListWatchRelistsMergedWatches(t *testing.T) {
+	nsQuery := NewNamespaceQuery(nil).Restrict([]string{"a", "b"})
+	reopened := make(chan string, 1)
+	watchers := make(chan *watch.FakeWatcher, 2)
+	opened := false
+	listWatch, err := NewListWatch("5", func(resourceVersion string) (watch.Interface, error) {
+		if opened {
+			reopened <- resourceVersion
+			return watch.NewFake(), nil
+		}
+		opened = true
+		return WatchInNamespaces(nsQuery, func(string) (watch.Interface, error) {
+			watcher := watch.NewFake()
+			watchers <- watcher
+			return watcher, nil
+		})
+	}, func(object runtime.Object) (interface{}, bool) {
+		return object.(*v1.ConfigMap).Name, true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer listWatch.Stop()
+	first := <-watchers
+	go func() {
+		first.Add(&v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "a", Name: "a1", ResourceVersion: "7"}})
+		first.Stop()
+	}()
+
+	expected := []WatchEvent{
+		{Type: watch.Added, Object: "a1"},
+		{Type: WatchEventReset},
+	}
+	for i, want := range expected {
+		got := <-listWatch.ResultChan()
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("event %d is %+v, expected %+v", i, got, want)
+		}
+	}
+	if resourceVersion := <-reopened; resourceVersion != "" {
+		t.Errorf("expected the merged watch to be relisted, got %q", resourceVersion)
+	}
+}
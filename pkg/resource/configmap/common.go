@@ -18,6 +18,7 @@ package configmap
 
 import (
 	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 )
@@ -42,6 +43,16 @@ func (c ConfigMapCell) GetProperty(name dataselect.PropertyName) dataselect.Comp
 	}
 }
 
+// GetLabels returns the labels of the config map for label selectors.
+func (c ConfigMapCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the config map for field selectors.
+func (c ConfigMapCell) GetFields() fields.Set {
+	return dataselect.ObjectMetaFields(c.ObjectMeta)
+}
+
 func toCells(std []api.ConfigMap) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
@@ -51,7 +51,7 @@ type ConfigMap struct {
 func GetConfigMapList(client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*ConfigMapList, error) {
 	log.Printf("Getting list config maps in the namespace %s", nsQuery.ToRequestParam())
 	channels := &common.ResourceChannels{
-		ConfigMapList: common.GetConfigMapListChannel(client, nsQuery, 1),
+		ConfigMapList: common.GetConfigMapListChannelWithOptions(client, nsQuery, dsQuery.FilterQuery.ListOptions(), 1),
 	}
 
 	return GetConfigMapListFromChannels(channels, dsQuery)
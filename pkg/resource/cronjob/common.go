@@ -18,6 +18,7 @@ package cronjob
 
 import (
 	batch "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 	"github.com/karmada-io/dashboard/pkg/resource/common"
@@ -43,6 +44,16 @@ func (c CronJobCell) GetProperty(name dataselect.PropertyName) dataselect.Compar
 	}
 }
 
+// GetLabels returns the labels of the cron job for label selectors.
+func (c CronJobCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the cron job for field selectors.
+func (c CronJobCell) GetFields() fields.Set {
+	return dataselect.ObjectMetaFields(c.ObjectMeta)
+}
+
 // ToCells converts []batch.CronJob to []CronJobCell
 func ToCells(std []batch.CronJob) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
@@ -60,7 +60,7 @@ func GetCronJobList(client client.Interface, nsQuery *common.NamespaceQuery,
 	log.Print("Getting list of all cron jobs in the cluster")
 
 	channels := &common.ResourceChannels{
-		CronJobList: common.GetCronJobListChannel(client, nsQuery, 1),
+		CronJobList: common.GetCronJobListChannelWithOptions(client, nsQuery, dsQuery.FilterQuery.ListOptions(), 1),
 	}
 
 	return GetCronJobListFromChannels(channels, dsQuery)
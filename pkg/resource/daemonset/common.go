@@ -82,6 +82,16 @@ func (c DaemonSetCell) GetProperty(name dataselect.PropertyName) dataselect.Comp
 	}
 }
 
+// GetLabels returns the labels of the daemon set for label selectors.
+func (c DaemonSetCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the daemon set for field selectors.
+func (c DaemonSetCell) GetFields() fields.Set {
+	return dataselect.ObjectMetaFields(c.ObjectMeta)
+}
+
 // ToCells converts a slice of DaemonSets to a slice of DataCells.
 func ToCells(std []apps.DaemonSet) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
@@ -50,7 +50,7 @@ type DaemonSet struct {
 // GetDaemonSetList returns a list of all Daemon Set in the cluster.
 func GetDaemonSetList(client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*DaemonSetList, error) {
 	channels := &common.ResourceChannels{
-		DaemonSetList: common.GetDaemonSetListChannel(client, nsQuery, 1),
+		DaemonSetList: common.GetDaemonSetListChannelWithOptions(client, nsQuery, dsQuery.FilterQuery.ListOptions(), 1),
 		ServiceList:   common.GetServiceListChannel(client, nsQuery, 1),
 		PodList:       common.GetPodListChannel(client, nsQuery, 1),
 		EventList:     common.GetEventListChannel(client, nsQuery, 1),
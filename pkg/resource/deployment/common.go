@@ -19,6 +19,7 @@ package deployment
 import (
 	apps "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 	"github.com/karmada-io/dashboard/pkg/resource/common"
@@ -43,6 +44,16 @@ func (c DeploymentCell) GetProperty(name dataselect.PropertyName) dataselect.Com
 	}
 }
 
+// GetLabels returns the labels of the deployment for label selectors.
+func (c DeploymentCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the deployment for field selectors.
+func (c DeploymentCell) GetFields() fields.Set {
+	return dataselect.ObjectMetaFields(c.ObjectMeta)
+}
+
 func toCells(std []apps.Deployment) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
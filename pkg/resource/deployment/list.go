@@ -17,13 +17,17 @@ limitations under the License.
 package deployment
 
 import (
+	"context"
 	"log"
 
 	apps "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	client "k8s.io/client-go/kubernetes"
 
 	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
 	"github.com/karmada-io/dashboard/pkg/common/types"
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 	"github.com/karmada-io/dashboard/pkg/resource/common"
@@ -66,7 +70,7 @@ func GetDeploymentList(client client.Interface, nsQuery *common.NamespaceQuery,
 	log.Print("Getting list of all deployments in the cluster")
 
 	channels := &common.ResourceChannels{
-		DeploymentList: common.GetDeploymentListChannel(client, nsQuery, 1),
+		DeploymentList: common.GetDeploymentListChannelWithOptions(client, nsQuery, dsQuery.FilterQuery.ListOptions(), 1),
 		PodList:        common.GetPodListChannel(client, nsQuery, 1),
 		EventList:      common.GetEventListChannel(client, nsQuery, 1),
 		ReplicaSetList: common.GetReplicaSetListChannel(client, nsQuery, 1),
@@ -144,3 +148,68 @@ func toDeployment(deployment *apps.Deployment, rs []apps.ReplicaSet, pods []v1.P
 		InitContainerImages: common.GetInitContainerImages(&deployment.Spec.Template.Spec),
 	}
 }
+
+// WatchDeploymentList watches the deployments of the list, see common.ListWatch. The replica sets, pods
+// and events of the namespaces of a batch of changed deployments are listed once for the batch and
+// matched to the deployments like for the list.
+func WatchDeploymentList(client client.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery, resourceVersion string) (*common.ListWatch, error) {
+	return common.NewBatchListWatch(resourceVersion, func(resourceVersion string) (watch.Interface, error) {
+		return common.WatchInNamespaces(nsQuery, func(namespace string) (watch.Interface, error) {
+			return client.AppsV1().Deployments(namespace).Watch(context.TODO(), helpers.WatchFrom(resourceVersion))
+		})
+	}, func(objects []runtime.Object) common.ConvertFunc {
+		resources := getNamespaceResources(client, objects)
+		return func(object runtime.Object) (interface{}, bool) {
+			deployment, ok := object.(*apps.Deployment)
+			if !ok {
+				return nil, false
+			}
+			r := resources[deployment.Namespace]
+			return toDeployment(deployment, r.replicaSets, r.pods, r.events), dsQuery.FilterQuery.Matches(DeploymentCell(*deployment))
+		}
+	})
+}
+
+// namespaceResources are the resources of a namespace deployments are shown with.
+type namespaceResources struct {
+	replicaSets []apps.ReplicaSet
+	pods        []v1.Pod
+	events      []v1.Event
+}
+
+// getNamespaceResources lists the replica sets, pods and events of the namespaces of the deployments,
+// the ones which can not be listed are left out.
+func getNamespaceResources(client client.Interface, objects []runtime.Object) map[string]namespaceResources {
+	result := make(map[string]namespaceResources)
+	for _, object := range objects {
+		deployment, ok := object.(*apps.Deployment)
+		if !ok {
+			continue
+		}
+		namespace := deployment.Namespace
+		if _, listed := result[namespace]; listed {
+			continue
+		}
+		nsQuery := common.NewSameNamespaceQuery(namespace)
+		channels := &common.ResourceChannels{
+			ReplicaSetList: common.GetReplicaSetListChannel(client, nsQuery, 1),
+			PodList:        common.GetPodListChannel(client, nsQuery, 1),
+			EventList:      common.GetEventListChannel(client, nsQuery, 1),
+		}
+
+		rs := <-channels.ReplicaSetList.List
+		if err := <-channels.ReplicaSetList.Error; err != nil {
+			log.Printf("Couldn't list replica sets of namespace %s: %v", namespace, err)
+		}
+		pods := <-channels.PodList.List
+		if err := <-channels.PodList.Error; err != nil {
+			log.Printf("Couldn't list pods of namespace %s: %v", namespace, err)
+		}
+		events := <-channels.EventList.List
+		if err := <-channels.EventList.Error; err != nil {
+			log.Printf("Couldn't list events of namespace %s: %v", namespace, err)
+		}
+		result[namespace] = namespaceResources{replicaSets: rs.Items, pods: pods.Items, events: events.Items}
+	}
+	return result
+}
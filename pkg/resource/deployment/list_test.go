@@ -0,0 +1,94 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
+)
+
+func TestWatchDeploymentList(t *testing.T) {
+	deployment := func(name string) *apps.Deployment {
+		return &apps.Deployment{ObjectMeta: metaV1.ObjectMeta{
+			Namespace: "default", Name: name, UID: types.UID(name),
+		}}
+	}
+	controlledBy := func(kind, name string) []metaV1.OwnerReference {
+		controller := true
+		return []metaV1.OwnerReference{{Kind: kind, Name: name, UID: types.UID(name), Controller: &controller}}
+	}
+	client := fake.NewSimpleClientset(
+		&apps.ReplicaSet{ObjectMeta: metaV1.ObjectMeta{
+			Namespace: "default", Name: "web-rs", UID: "web-rs", OwnerReferences: controlledBy("Deployment", "web"),
+		}},
+		&v1.Pod{
+			ObjectMeta: metaV1.ObjectMeta{
+				Namespace: "default", Name: "web-pod", UID: "web-pod", OwnerReferences: controlledBy("ReplicaSet", "web-rs"),
+			},
+			Status: v1.PodStatus{Phase: v1.PodPending},
+		},
+		&v1.Event{
+			ObjectMeta:     metaV1.ObjectMeta{Namespace: "default", Name: "web-pod.1"},
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "web-pod", UID: "web-pod"},
+			Type:           v1.EventTypeWarning,
+			Reason:         "FailedScheduling",
+		},
+	)
+
+	listWatch, err := WatchDeploymentList(client, common.NewNamespaceQuery(nil), dataselect.NoDataSelect, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer listWatch.Stop()
+	for _, name := range []string{"web", "api"} {
+		if _, err := client.AppsV1().Deployments("default").Create(context.TODO(), deployment(name), metaV1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deployments := map[string]Deployment{}
+	for len(deployments) < 2 {
+		event := <-listWatch.ResultChan()
+		item := event.Object.(Deployment)
+		deployments[item.ObjectMeta.Name] = item
+	}
+	if warnings := deployments["web"].Pods.Warnings; len(warnings) != 1 || warnings[0].Reason != "FailedScheduling" {
+		t.Errorf("expected the warning of the pod of web, got %+v", warnings)
+	}
+	if warnings := deployments["api"].Pods.Warnings; len(warnings) != 0 {
+		t.Errorf("expected no warnings for api, got %+v", warnings)
+	}
+
+	podLists := 0
+	for _, action := range client.Actions() {
+		if action.Matches("list", "pods") {
+			podLists++
+		}
+	}
+	if podLists != 1 {
+		t.Errorf("expected the pods to be listed once for the batch, got %d lists", podLists)
+	}
+}
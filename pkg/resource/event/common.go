@@ -178,6 +178,16 @@ func (c EventCell) GetProperty(name dataselect.PropertyName) dataselect.Comparab
 	}
 }
 
+// GetLabels returns the labels of the event for label selectors.
+func (c EventCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the event for field selectors.
+func (c EventCell) GetFields() fields.Set {
+	return dataselect.ObjectMetaFields(c.ObjectMeta)
+}
+
 func toCells(std []v1.Event) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
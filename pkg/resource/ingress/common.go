@@ -18,6 +18,7 @@ package ingress
 
 import (
 	v1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 )
@@ -42,6 +43,16 @@ func (c IngressCell) GetProperty(name dataselect.PropertyName) dataselect.Compar
 	}
 }
 
+// GetLabels returns the labels of the ingress for label selectors.
+func (c IngressCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the ingress for field selectors.
+func (c IngressCell) GetFields() fields.Set {
+	return dataselect.ObjectMetaFields(c.ObjectMeta)
+}
+
 func toCells(std []v1.Ingress) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
@@ -23,7 +23,6 @@ import (
 	client "k8s.io/client-go/kubernetes"
 
 	"github.com/karmada-io/dashboard/pkg/common/errors"
-	"github.com/karmada-io/dashboard/pkg/common/helpers"
 	"github.com/karmada-io/dashboard/pkg/common/types"
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 	"github.com/karmada-io/dashboard/pkg/resource/common"
@@ -53,7 +52,9 @@ type IngressList struct {
 // GetIngressList returns all ingresses in the given namespace.
 func GetIngressList(client client.Interface, namespace *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery) (*IngressList, error) {
-	ingressList, err := client.NetworkingV1().Ingresses(namespace.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	ingressList, err := common.ListInNamespaces(namespace, func(namespace string) (*v1.IngressList, error) {
+		return client.NetworkingV1().Ingresses(namespace).List(context.TODO(), dsQuery.FilterQuery.ListOptions())
+	})
 
 	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
 	if criticalError != nil {
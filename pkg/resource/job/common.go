@@ -19,6 +19,7 @@ package job
 import (
 	batch "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 	"github.com/karmada-io/dashboard/pkg/resource/common"
@@ -44,6 +45,16 @@ func (c JobCell) GetProperty(name dataselect.PropertyName) dataselect.Comparable
 	}
 }
 
+// GetLabels returns the labels of the job for label selectors.
+func (c JobCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the job for field selectors.
+func (c JobCell) GetFields() fields.Set {
+	return dataselect.ObjectMetaFields(c.ObjectMeta)
+}
+
 // ToCells converts a slice of Jobs to a slice of DataCells.
 func ToCells(std []batch.Job) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
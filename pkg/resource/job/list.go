@@ -94,7 +94,7 @@ func GetJobList(client client.Interface, nsQuery *common.NamespaceQuery,
 	log.Print("Getting list of all jobs in the cluster")
 
 	channels := &common.ResourceChannels{
-		JobList:   common.GetJobListChannel(client, nsQuery, 1),
+		JobList:   common.GetJobListChannelWithOptions(client, nsQuery, dsQuery.FilterQuery.ListOptions(), 1),
 		PodList:   common.GetPodListChannel(client, nsQuery, 1),
 		EventList: common.GetEventListChannel(client, nsQuery, 1),
 	}
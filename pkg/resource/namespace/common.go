@@ -22,6 +22,7 @@ import (
 
 	api "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
@@ -78,6 +79,18 @@ func (c NamespaceCell) GetProperty(name dataselect.PropertyName) dataselect.Comp
 	}
 }
 
+// GetLabels returns the labels of the namespace for label selectors.
+func (c NamespaceCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the namespace for field selectors.
+func (c NamespaceCell) GetFields() fields.Set {
+	set := dataselect.ObjectMetaFields(c.ObjectMeta)
+	set["status.phase"] = string(c.Status.Phase)
+	return set
+}
+
 func toCells(std []api.Namespace) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
@@ -24,9 +24,9 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/karmada-io/dashboard/pkg/common/errors"
-	"github.com/karmada-io/dashboard/pkg/common/helpers"
 	"github.com/karmada-io/dashboard/pkg/common/types"
 	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
 )
 
 // NamespaceList contains a list of namespaces in the cluster.
@@ -51,17 +51,23 @@ type Namespace struct {
 	SkipAutoPropagation bool              `json:"skipAutoPropagation"`
 }
 
-// GetNamespaceList returns a list of all namespaces in the cluster.
-func GetNamespaceList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*NamespaceList, error) {
+// GetNamespaceList returns a list of the namespaces in the cluster selected by nsQuery.
+func GetNamespaceList(client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*NamespaceList, error) {
 	log.Println("Getting list of namespaces")
-	namespaces, err := client.CoreV1().Namespaces().List(context.TODO(), helpers.ListEverything)
+	namespaces, err := client.CoreV1().Namespaces().List(context.TODO(), dsQuery.FilterQuery.ListOptions())
 
 	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
 	if criticalError != nil {
 		return nil, criticalError
 	}
 
-	return toNamespaceList(namespaces.Items, nonCriticalErrors, dsQuery), nil
+	selected := make([]v1.Namespace, 0, len(namespaces.Items))
+	for _, namespace := range namespaces.Items {
+		if nsQuery.Matches(namespace.Name) {
+			selected = append(selected, namespace)
+		}
+	}
+	return toNamespaceList(selected, nonCriticalErrors, dsQuery), nil
 }
 
 func toNamespaceList(namespaces []v1.Namespace, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *NamespaceList {
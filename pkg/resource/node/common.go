@@ -17,7 +17,10 @@ limitations under the License.
 package node
 
 import (
+	"strconv"
+
 	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 )
@@ -34,12 +37,36 @@ func (c NodeCell) GetProperty(name dataselect.PropertyName) dataselect.Comparabl
 		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
 	case dataselect.NamespaceProperty:
 		return dataselect.StdComparableString(c.ObjectMeta.Namespace)
+	case dataselect.ReadyProperty:
+		return dataselect.StdComparableBool(isNodeReady(c.Status))
 	default:
 		// if name is not supported then just return a constant dummy value, sort will have no effect.
 		return nil
 	}
 }
 
+// GetLabels returns the labels of the node for label selectors.
+func (c NodeCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the node for field selectors.
+func (c NodeCell) GetFields() fields.Set {
+	set := dataselect.ObjectMetaFields(c.ObjectMeta)
+	set["spec.unschedulable"] = strconv.FormatBool(c.Spec.Unschedulable)
+	return set
+}
+
+// isNodeReady returns whether the Ready condition of the node is true.
+func isNodeReady(status api.NodeStatus) bool {
+	for _, condition := range status.Conditions {
+		if condition.Type == api.NodeReady {
+			return condition.Status == api.ConditionTrue
+		}
+	}
+	return false
+}
+
 func toCells(std []api.Node) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
@@ -11,11 +11,15 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/karmada-io/dashboard/pkg/common/helpers"
 	"github.com/karmada-io/dashboard/pkg/common/types"
 	"github.com/karmada-io/dashboard/pkg/dataselect"
+	"github.com/karmada-io/dashboard/pkg/resource/common"
 	"github.com/karmada-io/dashboard/pkg/resource/pod"
 )
 
@@ -62,7 +66,7 @@ type ResourceInfo struct {
 // GetEnhancedNodeList 获取增强的节点列表
 func GetEnhancedNodeList(client kubernetes.Interface, clusterName string, dsQuery *dataselect.DataSelectQuery) (*EnhancedNodeList, error) {
 	// 获取节点列表
-	nodes, err := client.CoreV1().Nodes().List(context.TODO(), helpers.ListEverything)
+	nodes, err := client.CoreV1().Nodes().List(context.TODO(), dsQuery.FilterQuery.ListOptions())
 	if err != nil {
 		return nil, fmt.Errorf("failed to list nodes: %w", err)
 	}
@@ -98,6 +102,31 @@ func GetPodsOnNode(client kubernetes.Interface, nodeName string, dsQuery *datase
 	return convertToPodList(pods.Items, dsQuery), nil
 }
 
+// WatchEnhancedNodeList watches the nodes of the list, see common.ListWatch. The pod and resource
+// summaries of each changed node are computed like for the list, nodes are sent without them if
+// that fails.
+func WatchEnhancedNodeList(client kubernetes.Interface, clusterName string, dsQuery *dataselect.DataSelectQuery, resourceVersion string) (*common.ListWatch, error) {
+	return common.NewListWatch(resourceVersion, func(resourceVersion string) (watch.Interface, error) {
+		return client.CoreV1().Nodes().Watch(context.TODO(), helpers.WatchFrom(resourceVersion))
+	}, func(object runtime.Object) (interface{}, bool) {
+		node, ok := object.(*v1.Node)
+		if !ok {
+			return nil, false
+		}
+		enhancedNode, err := toEnhancedNode(client, clusterName, node)
+		if err != nil {
+			// the summaries are gone with deleted nodes, the node itself is still needed
+			enhancedNode = &EnhancedNode{
+				ObjectMeta:  types.NewObjectMeta(node.ObjectMeta),
+				TypeMeta:    types.NewTypeMeta(types.ResourceKindNode),
+				Status:      node.Status,
+				ClusterName: clusterName,
+			}
+		}
+		return *enhancedNode, dsQuery.FilterQuery.Matches(EnhancedNodeCell{*enhancedNode})
+	})
+}
+
 func toEnhancedNodeList(client kubernetes.Interface, clusterName string, nodes []v1.Node, dsQuery *dataselect.DataSelectQuery) (*EnhancedNodeList, error) {
 	enhancedNodes := make([]EnhancedNode, 0, len(nodes))
 
@@ -273,11 +302,26 @@ func (cell EnhancedNodeCell) GetProperty(name dataselect.PropertyName) dataselec
 		return dataselect.StdComparableString(cell.EnhancedNode.ObjectMeta.Name)
 	case dataselect.CreationTimestampProperty:
 		return dataselect.StdComparableTime(cell.EnhancedNode.ObjectMeta.CreationTimestamp.Time)
+	case dataselect.ReadyProperty:
+		return dataselect.StdComparableBool(isNodeReady(cell.EnhancedNode.Status))
 	default:
 		return nil
 	}
 }
 
+// GetLabels returns the labels of the node for label selectors.
+func (cell EnhancedNodeCell) GetLabels() map[string]string {
+	return cell.EnhancedNode.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the node for field selectors.
+func (cell EnhancedNodeCell) GetFields() fields.Set {
+	return fields.Set{
+		dataselect.NameField:      cell.EnhancedNode.ObjectMeta.Name,
+		dataselect.NamespaceField: cell.EnhancedNode.ObjectMeta.Namespace,
+	}
+}
+
 // convertToPodList 将v1.Pod列表转换为pod.PodList
 func convertToPodList(podItems []v1.Pod, dsQuery *dataselect.DataSelectQuery) *pod.PodList {
 	result := &pod.PodList{
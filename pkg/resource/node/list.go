@@ -53,7 +53,7 @@ type NodeList struct {
 func GetNodeList(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery) (*NodeList, error) {
 	log.Printf("Getting nodes")
 	channels := &common.ResourceChannels{
-		NodeList: common.GetNodeListChannel(client, 1),
+		NodeList: common.GetNodeListChannelWithOptions(client, dsQuery.FilterQuery.ListOptions(), 1),
 	}
 
 	return GetNodeListFromChannels(channels, dsQuery)
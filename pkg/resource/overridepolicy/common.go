@@ -18,6 +18,7 @@ package overridepolicy
 
 import (
 	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 )
@@ -41,6 +42,16 @@ func (c OverridePolicyCell) GetProperty(name dataselect.PropertyName) dataselect
 	}
 }
 
+// GetLabels returns the labels of the override policy for label selectors.
+func (c OverridePolicyCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the override policy for field selectors.
+func (c OverridePolicyCell) GetFields() fields.Set {
+	return dataselect.ObjectMetaFields(c.ObjectMeta)
+}
+
 func toCells(std []v1alpha1.OverridePolicy) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
@@ -22,6 +22,8 @@ import (
 
 	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/karmada-io/dashboard/pkg/common/errors"
@@ -54,7 +56,9 @@ type OverridePolicy struct {
 // GetOverridePolicyList returns a list of all override policies in the Karmada control-plane.
 func GetOverridePolicyList(client karmadaclientset.Interface, k8sClient kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*OverridePolicyList, error) {
 	log.Println("Getting list of overridepolicy")
-	overridePolicies, err := client.PolicyV1alpha1().OverridePolicies(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	overridePolicies, err := common.ListInNamespaces(nsQuery, func(namespace string) (*v1alpha1.OverridePolicyList, error) {
+		return client.PolicyV1alpha1().OverridePolicies(namespace).List(context.TODO(), dsQuery.FilterQuery.ListOptions())
+	})
 	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
 	if criticalError != nil {
 		return nil, criticalError
@@ -88,3 +92,18 @@ func toOverridePolicy(overridepolicy *v1alpha1.OverridePolicy) OverridePolicy {
 		OverrideRules:     overridepolicy.Spec.OverrideRules,
 	}
 }
+
+// WatchOverridePolicyList watches the override policies of the list, see common.ListWatch.
+func WatchOverridePolicyList(client karmadaclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery, resourceVersion string) (*common.ListWatch, error) {
+	return common.NewListWatch(resourceVersion, func(resourceVersion string) (watch.Interface, error) {
+		return common.WatchInNamespaces(nsQuery, func(namespace string) (watch.Interface, error) {
+			return client.PolicyV1alpha1().OverridePolicies(namespace).Watch(context.TODO(), helpers.WatchFrom(resourceVersion))
+		})
+	}, func(object runtime.Object) (interface{}, bool) {
+		overridepolicy, ok := object.(*v1alpha1.OverridePolicy)
+		if !ok {
+			return nil, false
+		}
+		return toOverridePolicy(overridepolicy), dsQuery.FilterQuery.Matches(OverridePolicyCell(*overridepolicy))
+	})
+}
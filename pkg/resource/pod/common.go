@@ -18,6 +18,7 @@ package pod
 
 import (
 	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 )
@@ -34,12 +35,33 @@ func (c PodCell) GetProperty(name dataselect.PropertyName) dataselect.Comparable
 		return dataselect.StdComparableTime(c.ObjectMeta.CreationTimestamp.Time)
 	case dataselect.NamespaceProperty:
 		return dataselect.StdComparableString(c.ObjectMeta.Namespace)
+	case dataselect.StatusProperty:
+		return dataselect.StdComparableString(c.Status.Phase)
+	case dataselect.RestartsProperty:
+		restarts := 0
+		for _, containerStatus := range c.Status.ContainerStatuses {
+			restarts += int(containerStatus.RestartCount)
+		}
+		return dataselect.StdComparableInt(restarts)
 	default:
 		// if name is not supported then just return a constant dummy value, sort will have no effect.
 		return nil
 	}
 }
 
+// GetLabels returns the labels of the pod for label selectors.
+func (c PodCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the pod for field selectors.
+func (c PodCell) GetFields() fields.Set {
+	set := dataselect.ObjectMetaFields(c.ObjectMeta)
+	set["spec.nodeName"] = c.Spec.NodeName
+	set["status.phase"] = string(c.Status.Phase)
+	return set
+}
+
 func toCells(std []api.Pod) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
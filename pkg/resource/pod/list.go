@@ -17,13 +17,17 @@ limitations under the License.
 package pod
 
 import (
+	"context"
 	"log"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/karmada-io/dashboard/pkg/common/errors"
+	"github.com/karmada-io/dashboard/pkg/common/helpers"
 	"github.com/karmada-io/dashboard/pkg/common/types"
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 	"github.com/karmada-io/dashboard/pkg/resource/common"
@@ -47,18 +51,28 @@ type PodList struct {
 	Errors []error `json:"errors"`
 }
 
-// GetPodList returns a list of all Pods in all cluster.
+// GetPodList returns a list of all Pods in all cluster. Cursor pagination is forwarded to the API
+// server when the list can be paginated by it, see DataSelectQuery.ForwardsCursor.
 func GetPodList(client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*PodList, error) {
 	log.Printf("Getting pods")
+	options := dsQuery.FilterQuery.ListOptions()
+	forwardCursor := dsQuery.ForwardsCursor() && nsQuery.IsSingleRequest()
+	if forwardCursor {
+		options = dsQuery.CursorListOptions()
+	}
 	channels := &common.ResourceChannels{
-		PodList: common.GetPodListChannel(client, nsQuery, 1),
+		PodList: common.GetPodListChannelWithOptions(client, nsQuery, options, 1),
 	}
 
-	return GetPodListFromChannels(channels, dsQuery)
+	return getPodListFromChannels(channels, dsQuery, forwardCursor)
 }
 
 // GetPodListFromChannels returns a list of all Pods in the cluster reading required resource list once from the channels.
 func GetPodListFromChannels(channels *common.ResourceChannels, dsQuery *dataselect.DataSelectQuery) (*PodList, error) {
+	return getPodListFromChannels(channels, dsQuery, false)
+}
+
+func getPodListFromChannels(channels *common.ResourceChannels, dsQuery *dataselect.DataSelectQuery, forwardedCursor bool) (*PodList, error) {
 	pods := <-channels.PodList.List
 	err := <-channels.PodList.Error
 	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
@@ -66,9 +80,11 @@ func GetPodListFromChannels(channels *common.ResourceChannels, dsQuery *datasele
 		return nil, criticalError
 	}
 
-	result := toPodList(pods.Items, nonCriticalErrors, dsQuery)
-
-	return result, nil
+	var forwarded *metav1.ListMeta
+	if forwardedCursor {
+		forwarded = &pods.ListMeta
+	}
+	return toPodList(pods.Items, forwarded, nonCriticalErrors, dsQuery)
 }
 
 func toPod(meta metav1.ObjectMeta, status v1.PodStatus) Pod {
@@ -79,22 +95,29 @@ func toPod(meta metav1.ObjectMeta, status v1.PodStatus) Pod {
 	}
 }
 
-func toPodList(pods []v1.Pod, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *PodList {
+func toPodList(pods []v1.Pod, forwarded *metav1.ListMeta, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) (*PodList, error) {
 	result := &PodList{
 		Items:    make([]Pod, 0),
 		ListMeta: types.ListMeta{TotalItems: len(pods)},
 		Errors:   nonCriticalErrors,
 	}
 
-	podCells, filteredTotal := dataselect.GenericDataSelectWithFilter(toCells(pods), dsQuery)
+	podCells, filteredTotal, cursor, err := dataselect.GenericDataSelectWithCursor(toCells(pods), dsQuery, forwarded)
+	if err != nil {
+		return nil, err
+	}
 	pods = fromCells(podCells)
-	result.ListMeta = types.ListMeta{TotalItems: filteredTotal}
+	result.ListMeta = types.ListMeta{
+		TotalItems:         filteredTotal,
+		Continue:           cursor.Continue,
+		RemainingItemCount: cursor.RemainingItemCount,
+	}
 
 	for _, item := range pods {
 		result.Items = append(result.Items, toPod(item.ObjectMeta, item.Status))
 	}
 
-	return result
+	return result, nil
 }
 
 // NewStatus returns a new status.
@@ -103,3 +126,18 @@ func NewStatus(status v1.PodStatus) v1.PodStatus {
 		Conditions: status.Conditions,
 	}
 }
+
+// WatchPodList watches the pods of the list, see common.ListWatch.
+func WatchPodList(client kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery, resourceVersion string) (*common.ListWatch, error) {
+	return common.NewListWatch(resourceVersion, func(resourceVersion string) (watch.Interface, error) {
+		return common.WatchInNamespaces(nsQuery, func(namespace string) (watch.Interface, error) {
+			return client.CoreV1().Pods(namespace).Watch(context.TODO(), helpers.WatchFrom(resourceVersion))
+		})
+	}, func(object runtime.Object) (interface{}, bool) {
+		pod, ok := object.(*v1.Pod)
+		if !ok {
+			return nil, false
+		}
+		return toPod(pod.ObjectMeta, pod.Status), dsQuery.FilterQuery.Matches(PodCell(*pod))
+	})
+}
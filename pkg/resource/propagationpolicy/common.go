@@ -18,6 +18,7 @@ package propagationpolicy
 
 import (
 	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 )
@@ -42,6 +43,16 @@ func (c PropagationPolicyCell) GetProperty(name dataselect.PropertyName) datasel
 	}
 }
 
+// GetLabels returns the labels of the propagation policy for label selectors.
+func (c PropagationPolicyCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the propagation policy for field selectors.
+func (c PropagationPolicyCell) GetFields() fields.Set {
+	return dataselect.ObjectMetaFields(c.ObjectMeta)
+}
+
 func toCells(std []v1alpha1.PropagationPolicy) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
@@ -24,6 +24,8 @@ import (
 
 	"github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
 	karmadaclientset "github.com/karmada-io/karmada/pkg/generated/clientset/versioned"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/karmada-io/dashboard/pkg/client"
@@ -58,7 +60,9 @@ type PropagationPolicy struct {
 // GetPropagationPolicyList returns a list of all propagations in the karmada control-plance.
 func GetPropagationPolicyList(client karmadaclientset.Interface, k8sClient kubernetes.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery) (*PropagationPolicyList, error) {
 	log.Println("Getting list of namespaces")
-	propagationpolicies, err := client.PolicyV1alpha1().PropagationPolicies(nsQuery.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	propagationpolicies, err := common.ListInNamespaces(nsQuery, func(namespace string) (*v1alpha1.PropagationPolicyList, error) {
+		return client.PolicyV1alpha1().PropagationPolicies(namespace).List(context.TODO(), dsQuery.FilterQuery.ListOptions())
+	})
 	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
 	if criticalError != nil {
 		return nil, criticalError
@@ -82,25 +86,26 @@ func toPropagationPolicyList(_ kubernetes.Interface, propagationpolicies []v1alp
 		panic(err)
 	}
 	for _, propagationpolicy := range propagationpolicies {
-		relatedResources := make([]string, 0)
-		for _, rs := range propagationpolicy.Spec.ResourceSelectors {
-			getRes, getErr := verberClient.Get(strings.ToLower(rs.Kind), rs.Namespace, rs.Name)
-			if getErr != nil {
-				continue
-			}
-			if errors.IsNotFound(err) || getRes == nil {
-				continue
-			}
-			relatedResources = append(relatedResources, fmt.Sprintf("%s/%s", rs.Namespace, rs.Name))
-		}
-
 		pp := toPropagationPolicy(&propagationpolicy)
-		pp.RelatedResources = relatedResources
+		pp.RelatedResources = relatedResources(verberClient, &propagationpolicy)
 		propagationpolicyList.PropagationPolicys = append(propagationpolicyList.PropagationPolicys, pp)
 	}
 	return propagationpolicyList
 }
 
+// relatedResources returns namespace/name of the selected resources which exist.
+func relatedResources(verberClient client.ResourceVerber, propagationpolicy *v1alpha1.PropagationPolicy) []string {
+	relatedResources := make([]string, 0)
+	for _, rs := range propagationpolicy.Spec.ResourceSelectors {
+		getRes, getErr := verberClient.Get(strings.ToLower(rs.Kind), rs.Namespace, rs.Name)
+		if getErr != nil || getRes == nil {
+			continue
+		}
+		relatedResources = append(relatedResources, fmt.Sprintf("%s/%s", rs.Namespace, rs.Name))
+	}
+	return relatedResources
+}
+
 func toPropagationPolicy(propagationpolicy *v1alpha1.PropagationPolicy) PropagationPolicy {
 	return PropagationPolicy{
 		ObjectMeta:      types.NewObjectMeta(propagationpolicy.ObjectMeta),
@@ -109,3 +114,24 @@ func toPropagationPolicy(propagationpolicy *v1alpha1.PropagationPolicy) Propagat
 		ClusterAffinity: propagationpolicy.Spec.Placement.ClusterAffinity,
 	}
 }
+
+// WatchPropagationPolicyList watches the propagation policies of the list, see common.ListWatch.
+func WatchPropagationPolicyList(karmadaClient karmadaclientset.Interface, nsQuery *common.NamespaceQuery, dsQuery *dataselect.DataSelectQuery, resourceVersion string) (*common.ListWatch, error) {
+	verberClient, err := client.VerberClient(nil)
+	if err != nil {
+		return nil, err
+	}
+	return common.NewListWatch(resourceVersion, func(resourceVersion string) (watch.Interface, error) {
+		return common.WatchInNamespaces(nsQuery, func(namespace string) (watch.Interface, error) {
+			return karmadaClient.PolicyV1alpha1().PropagationPolicies(namespace).Watch(context.TODO(), helpers.WatchFrom(resourceVersion))
+		})
+	}, func(object runtime.Object) (interface{}, bool) {
+		propagationpolicy, ok := object.(*v1alpha1.PropagationPolicy)
+		if !ok {
+			return nil, false
+		}
+		pp := toPropagationPolicy(propagationpolicy)
+		pp.RelatedResources = relatedResources(verberClient, propagationpolicy)
+		return pp, dsQuery.FilterQuery.Matches(PropagationPolicyCell(*propagationpolicy))
+	})
+}
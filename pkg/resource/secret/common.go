@@ -18,6 +18,7 @@ package secret
 
 import (
 	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 )
@@ -40,6 +41,18 @@ func (c SecretCell) GetProperty(name dataselect.PropertyName) dataselect.Compara
 	}
 }
 
+// GetLabels returns the labels of the secret for label selectors.
+func (c SecretCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the secret for field selectors.
+func (c SecretCell) GetFields() fields.Set {
+	set := dataselect.ObjectMetaFields(c.ObjectMeta)
+	set["type"] = string(c.Type)
+	return set
+}
+
 func toCells(std []api.Secret) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
@@ -35,6 +35,7 @@ type SecretDetail struct {
 	// or leading dot followed by valid DNS_SUBDOMAIN.
 	// The serialized form of the secret data is a base64 encoded string,
 	// representing the arbitrary (possibly non-string) data value here.
+	// Values are replaced by RedactedValue, use RevealSecretData to read them.
 	Data map[string][]byte `json:"data"`
 }
 
@@ -53,6 +54,6 @@ func GetSecretDetail(client kubernetes.Interface, namespace, name string) (*Secr
 func getSecretDetail(rawSecret *v1.Secret) *SecretDetail {
 	return &SecretDetail{
 		Secret: toSecret(rawSecret),
-		Data:   rawSecret.Data,
+		Data:   RedactData(rawSecret.Data),
 	}
 }
@@ -25,7 +25,6 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/karmada-io/dashboard/pkg/common/errors"
-	"github.com/karmada-io/dashboard/pkg/common/helpers"
 	"github.com/karmada-io/dashboard/pkg/common/types"
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 	"github.com/karmada-io/dashboard/pkg/resource/common"
@@ -89,8 +88,10 @@ type SecretList struct {
 // GetSecretList returns all secrets in the given namespace.
 func GetSecretList(client kubernetes.Interface, namespace *common.NamespaceQuery,
 	dsQuery *dataselect.DataSelectQuery) (*SecretList, error) {
-	log.Printf("Getting list of secrets in %s namespace\n", namespace)
-	secretList, err := client.CoreV1().Secrets(namespace.ToRequestParam()).List(context.TODO(), helpers.ListEverything)
+	log.Printf("Getting list of secrets in %s namespace\n", namespace.ToRequestParam())
+	secretList, err := common.ListInNamespaces(namespace, func(namespace string) (*v1.SecretList, error) {
+		return client.CoreV1().Secrets(namespace).List(context.TODO(), dsQuery.FilterQuery.ListOptions())
+	})
 
 	nonCriticalErrors, criticalError := errors.ExtractErrors(err)
 	if criticalError != nil {
@@ -118,7 +119,7 @@ func CreateSecret(client kubernetes.Interface, spec SecretSpec) (*Secret, error)
 
 func toSecret(secret *v1.Secret) Secret {
 	return Secret{
-		ObjectMeta: types.NewObjectMeta(secret.ObjectMeta),
+		ObjectMeta: types.NewObjectMeta(redactObjectMeta(secret.ObjectMeta)),
 		TypeMeta:   types.NewTypeMeta(types.ResourceKindSecret),
 		Type:       secret.Type,
 	}
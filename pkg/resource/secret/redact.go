@@ -0,0 +1,113 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"encoding/base64"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// RedactedValue replaces the values of secret data in API responses, the keys are kept.
+const RedactedValue = "******"
+
+// RedactData returns a copy of data with every value replaced by RedactedValue.
+func RedactData(data map[string][]byte) map[string][]byte {
+	if data == nil {
+		return nil
+	}
+	redacted := make(map[string][]byte, len(data))
+	for key := range data {
+		redacted[key] = []byte(RedactedValue)
+	}
+	return redacted
+}
+
+// redactObjectMeta drops the annotations which can carry a copy of the secret data.
+func redactObjectMeta(meta metaV1.ObjectMeta) metaV1.ObjectMeta {
+	if _, ok := meta.Annotations[v1.LastAppliedConfigAnnotation]; !ok {
+		return meta
+	}
+	annotations := make(map[string]string, len(meta.Annotations))
+	for key, value := range meta.Annotations {
+		if key != v1.LastAppliedConfigAnnotation {
+			annotations[key] = value
+		}
+	}
+	meta.Annotations = annotations
+	return meta
+}
+
+// IsSecret returns true if the object is a core v1 Secret.
+func IsSecret(obj *unstructured.Unstructured) bool {
+	return obj.GetAPIVersion() == "v1" && obj.GetKind() == "Secret"
+}
+
+// RedactUnstructured masks data and stringData of a Secret in place, other objects are left untouched.
+func RedactUnstructured(obj *unstructured.Unstructured) {
+	if !IsSecret(obj) {
+		return
+	}
+	masked := base64.StdEncoding.EncodeToString([]byte(RedactedValue))
+	for _, field := range []string{"data", "stringData"} {
+		values, ok := obj.Object[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key := range values {
+			if field == "data" {
+				values[key] = masked
+			} else {
+				values[key] = RedactedValue
+			}
+		}
+	}
+	if annotations := obj.GetAnnotations(); annotations != nil {
+		if _, ok := annotations[v1.LastAppliedConfigAnnotation]; ok {
+			delete(annotations, v1.LastAppliedConfigAnnotation)
+			obj.SetAnnotations(annotations)
+		}
+	}
+}
+
+// RestoreRedacted replaces the values of obj which still hold the mask of RedactUnstructured with the
+// values of current, so that a redacted secret can be edited and written back without losing data.
+func RestoreRedacted(obj, current *unstructured.Unstructured) {
+	masked := base64.StdEncoding.EncodeToString([]byte(RedactedValue))
+	currentData, _, _ := unstructured.NestedStringMap(current.Object, "data")
+	if data, ok := obj.Object["data"].(map[string]interface{}); ok {
+		for key, value := range data {
+			if value != masked {
+				continue
+			}
+			if currentValue, ok := currentData[key]; ok {
+				data[key] = currentValue
+			} else {
+				delete(data, key)
+			}
+		}
+	}
+	if stringData, ok := obj.Object["stringData"].(map[string]interface{}); ok {
+		for key, value := range stringData {
+			if value == RedactedValue {
+				delete(stringData, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newSecret() *v1.Secret {
+	return &v1.Secret{
+		TypeMeta: metaV1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:        "creds",
+			Namespace:   "default",
+			Annotations: map[string]string{v1.LastAppliedConfigAnnotation: `{"data":{"password":"c2VjcmV0"}}`, "team": "a"},
+		},
+		Data: map[string][]byte{"username": []byte("admin"), "password": []byte("secret")},
+	}
+}
+
+func TestGetSecretDetailRedactsData(t *testing.T) {
+	detail := getSecretDetail(newSecret())
+	for key, value := range detail.Data {
+		if string(value) != RedactedValue {
+			t.Errorf("expected %s to be redacted, got %q", key, value)
+		}
+	}
+	if _, ok := detail.ObjectMeta.Annotations[v1.LastAppliedConfigAnnotation]; ok {
+		t.Errorf("expected the last applied configuration to be dropped")
+	}
+	if detail.ObjectMeta.Annotations["team"] != "a" {
+		t.Errorf("expected other annotations to be kept")
+	}
+}
+
+func TestRedactAndRestoreUnstructured(t *testing.T) {
+	raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(newSecret())
+	if err != nil {
+		t.Fatal(err)
+	}
+	current := &unstructured.Unstructured{Object: raw}
+	obj := current.DeepCopy()
+	RedactUnstructured(obj)
+	data, _, _ := unstructured.NestedStringMap(obj.Object, "data")
+	if data["password"] != "KioqKioq" || data["username"] != "KioqKioq" {
+		t.Fatalf("expected data to be masked, got %v", data)
+	}
+	if _, ok := obj.GetAnnotations()[v1.LastAppliedConfigAnnotation]; ok {
+		t.Errorf("expected the last applied configuration to be dropped")
+	}
+
+	// the user changes the username and leaves the password alone
+	_ = unstructured.SetNestedField(obj.Object, "cm9vdA==", "data", "username")
+	RestoreRedacted(obj, current)
+	data, _, _ = unstructured.NestedStringMap(obj.Object, "data")
+	want := map[string]string{"username": "cm9vdA==", "password": "c2VjcmV0"}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("expected %v, got %v", want, data)
+	}
+}
+
+func TestRevealSecretData(t *testing.T) {
+	client := fake.NewSimpleClientset(newSecret())
+	reveal, err := RevealSecretData(client, "default", "creds", []string{"password"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(reveal.Data, map[string]string{"password": "secret"}) {
+		t.Errorf("unexpected revealed data %v", reveal.Data)
+	}
+	if _, err := RevealSecretData(client, "default", "creds", []string{"token"}); err == nil {
+		t.Errorf("expected an error for a missing key")
+	}
+}
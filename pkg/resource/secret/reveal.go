@@ -0,0 +1,62 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/karmada-io/dashboard/pkg/common/errors"
+)
+
+// SecretReveal holds the decoded values of the revealed keys of a secret.
+type SecretReveal struct {
+	Namespace string            `json:"namespace"`
+	Name      string            `json:"name"`
+	Data      map[string]string `json:"data"`
+}
+
+// RevealSecretData returns the decoded values of the given keys of a secret, or of all keys if none
+// are given. Asking for a key the secret does not have is an error.
+func RevealSecretData(client kubernetes.Interface, namespace, name string, keys []string) (*SecretReveal, error) {
+	log.Printf("Revealing data of %s secret in %s namespace\n", name, namespace)
+
+	rawSecret, err := client.CoreV1().Secrets(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	reveal := &SecretReveal{Namespace: namespace, Name: name, Data: make(map[string]string)}
+	if len(keys) == 0 {
+		for key, value := range rawSecret.Data {
+			reveal.Data[key] = string(value)
+		}
+		return reveal, nil
+	}
+	for _, key := range keys {
+		value, ok := rawSecret.Data[key]
+		if !ok {
+			return nil, errors.NewNotFound(fmt.Sprintf("secret %s/%s has no key %q", namespace, name, key))
+		}
+		reveal.Data[key] = string(value)
+	}
+	return reveal, nil
+}
@@ -18,6 +18,7 @@ package service
 
 import (
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 )
@@ -44,6 +45,16 @@ func (c ServiceCell) GetProperty(name dataselect.PropertyName) dataselect.Compar
 	}
 }
 
+// GetLabels returns the labels of the service for label selectors.
+func (c ServiceCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the service for field selectors.
+func (c ServiceCell) GetFields() fields.Set {
+	return dataselect.ObjectMetaFields(c.ObjectMeta)
+}
+
 func toCells(std []v1.Service) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
@@ -69,7 +69,7 @@ func GetServiceList(client client.Interface, nsQuery *common.NamespaceQuery,
 	log.Print("Getting list of all services in the cluster")
 
 	channels := &common.ResourceChannels{
-		ServiceList: common.GetServiceListChannel(client, nsQuery, 1),
+		ServiceList: common.GetServiceListChannelWithOptions(client, nsQuery, dsQuery.FilterQuery.ListOptions(), 1),
 	}
 
 	return GetServiceListFromChannels(channels, dsQuery)
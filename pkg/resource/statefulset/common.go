@@ -19,6 +19,7 @@ package statefulset
 import (
 	apps "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
 
 	"github.com/karmada-io/dashboard/pkg/dataselect"
 	"github.com/karmada-io/dashboard/pkg/resource/common"
@@ -45,6 +46,16 @@ func (c StatefulSetCell) GetProperty(name dataselect.PropertyName) dataselect.Co
 	}
 }
 
+// GetLabels returns the labels of the stateful set for label selectors.
+func (c StatefulSetCell) GetLabels() map[string]string {
+	return c.ObjectMeta.Labels
+}
+
+// GetFields returns the fields of the stateful set for field selectors.
+func (c StatefulSetCell) GetFields() fields.Set {
+	return dataselect.ObjectMetaFields(c.ObjectMeta)
+}
+
 func toCells(std []apps.StatefulSet) []dataselect.DataCell {
 	cells := make([]dataselect.DataCell, len(std))
 	for i := range std {
@@ -56,7 +56,7 @@ func GetStatefulSetList(client kubernetes.Interface, nsQuery *common.NamespaceQu
 	log.Print("Getting list of all stateful sets in the cluster")
 
 	channels := &common.ResourceChannels{
-		StatefulSetList: common.GetStatefulSetListChannel(client, nsQuery, 1),
+		StatefulSetList: common.GetStatefulSetListChannelWithOptions(client, nsQuery, dsQuery.FilterQuery.ListOptions(), 1),
 		PodList:         common.GetPodListChannel(client, nsQuery, 1),
 		EventList:       common.GetEventListChannel(client, nsQuery, 1),
 	}
@@ -0,0 +1,184 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serving
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/klog/v2"
+)
+
+// reloadInterval is how often the certificate files are checked for changes. Polling the file contents
+// also picks up the symlink swaps of mounted Secrets, which file watches tend to miss.
+const reloadInterval = 10 * time.Second
+
+// certReloader serves the current certificate and client CAs and reloads them when the files change.
+type certReloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	clients *x509.CertPool
+	// content of the files the current state was loaded from
+	loaded [][]byte
+}
+
+func newCertReloader(certFile, keyFile, caFile string) (*certReloader, error) {
+	if (certFile == "") != (keyFile == "") {
+		return nil, fmt.Errorf("both a certificate and a private key file are required for HTTPS serving")
+	}
+	r := &certReloader{certFile: certFile, keyFile: keyFile, caFile: caFile}
+	if certFile == "" {
+		klog.Warning("No serving certificate configured, using a self-signed certificate which is only suited for development")
+		cert, err := selfSignedCertificate()
+		if err != nil {
+			return nil, err
+		}
+		r.cert = cert
+	}
+	if _, err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *certReloader) run(stopCh <-chan struct{}) {
+	if r.certFile == "" && r.caFile == "" {
+		return
+	}
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			changed, err := r.reload()
+			if err != nil {
+				klog.ErrorS(err, "Could not reload serving certificates, keeping the previous ones")
+				continue
+			}
+			if changed {
+				klog.InfoS("Reloaded serving certificates", "cert", r.certFile, "clientCA", r.caFile)
+			}
+		}
+	}
+}
+
+// reload loads the files again if their content changed.
+func (r *certReloader) reload() (bool, error) {
+	var files [][]byte
+	for _, name := range []string{r.certFile, r.keyFile, r.caFile} {
+		if name == "" {
+			files = append(files, nil)
+			continue
+		}
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return false, err
+		}
+		files = append(files, content)
+	}
+
+	r.mu.RLock()
+	unchanged := r.loaded != nil && equal(r.loaded, files)
+	r.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	var cert *tls.Certificate
+	if r.certFile != "" {
+		pair, err := tls.X509KeyPair(files[0], files[1])
+		if err != nil {
+			return false, fmt.Errorf("invalid serving certificate %s: %w", r.certFile, err)
+		}
+		cert = &pair
+	}
+	var clients *x509.CertPool
+	if r.caFile != "" {
+		clients = x509.NewCertPool()
+		if !clients.AppendCertsFromPEM(files[2]) {
+			return false, fmt.Errorf("no certificates found in client CA file %s", r.caFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if cert != nil {
+		r.cert = cert
+	}
+	r.clients = clients
+	r.loaded = files
+	return true, nil
+}
+
+func (r *certReloader) tlsConfig() *tls.Config {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			return r.cert, nil
+		},
+	}
+	if r.caFile != "" {
+		config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			clientConfig := config.Clone()
+			clientConfig.GetConfigForClient = nil
+			clientConfig.ClientCAs = r.clients
+			clientConfig.ClientAuth = tls.RequireAndVerifyClientCert
+			return clientConfig, nil
+		}
+	}
+	return config
+}
+
+func selfSignedCertificate() (*tls.Certificate, error) {
+	certPEM, keyPEM, err := certutil.GenerateSelfSignedCertKey("karmada-dashboard", nil, []string{"localhost"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate self-signed certificate: %w", err)
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+func equal(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
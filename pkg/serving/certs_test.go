@@ -0,0 +1,95 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serving
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	certutil "k8s.io/client-go/util/cert"
+)
+
+func writeCertificate(t *testing.T, dir, host string) (string, string) {
+	certPEM, keyPEM, err := certutil.GenerateSelfSignedCertKey(host, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	if err = os.WriteFile(certFile, certPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCertificate(t, dir, "first")
+	reloader, err := newCertReloader(certFile, keyFile, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, _ := reloader.tlsConfig().GetCertificate(nil)
+
+	if changed, err := reloader.reload(); err != nil || changed {
+		t.Fatalf("reload() of unchanged files = %v, %v, expected no change", changed, err)
+	}
+
+	writeCertificate(t, dir, "second")
+	if changed, err := reloader.reload(); err != nil || !changed {
+		t.Fatalf("reload() of changed files = %v, %v, expected a change", changed, err)
+	}
+	second, _ := reloader.tlsConfig().GetCertificate(nil)
+	if first == second {
+		t.Errorf("expected the reloaded certificate to be served")
+	}
+
+	// a broken file keeps the previous certificate
+	if err = os.WriteFile(keyFile, []byte("broken"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = reloader.reload(); err == nil {
+		t.Errorf("expected reload() to fail for a broken key")
+	}
+	if current, _ := reloader.tlsConfig().GetCertificate(nil); current != second {
+		t.Errorf("expected the previous certificate to be kept")
+	}
+}
+
+func TestSelfSignedFallback(t *testing.T) {
+	reloader, err := newCertReloader("", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cert, _ := reloader.tlsConfig().GetCertificate(nil); cert == nil {
+		t.Errorf("expected a self-signed certificate")
+	}
+	if _, err = newCertReloader("tls.crt", "", ""); err == nil {
+		t.Errorf("expected an error for a certificate without a key")
+	}
+}
+
+func TestServeWithoutCertificate(t *testing.T) {
+	// without a certificate and without opting into a self-signed one, HTTPS stays disabled
+	if err := Serve(http.NotFoundHandler(), Config{SecureAddress: "127.0.0.1:0"}, make(chan struct{})); err == nil {
+		t.Errorf("expected an error as no listener is left")
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serving
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	"k8s.io/klog/v2"
+)
+
+// Config describes the listeners of a dashboard server.
+type Config struct {
+	// SecureAddress is the address of the HTTPS listener, empty disables it.
+	SecureAddress string
+	// InsecureAddress is the address of the plain HTTP listener, empty disables it.
+	InsecureAddress string
+	// CertFile and KeyFile hold the serving certificate. If both are empty the HTTPS listener is
+	// disabled, unless SelfSigned is set.
+	CertFile string
+	KeyFile  string
+	// SelfSigned serves HTTPS with a generated self-signed certificate when no certificate is
+	// configured, which is only meant for development.
+	SelfSigned bool
+	// ClientCAFile enables mutual TLS, clients must present a certificate signed by one of its CAs.
+	ClientCAFile string
+}
+
+// Address joins host and port, it returns an empty address for port 0 so that the listener is disabled.
+func Address(host net.IP, port int) string {
+	if port == 0 {
+		return ""
+	}
+	return net.JoinHostPort(host.String(), strconv.Itoa(port))
+}
+
+// Serve starts the configured listeners in the background. Certificate and CA files are watched
+// and reloaded until stopCh is closed.
+func Serve(handler http.Handler, config Config, stopCh <-chan struct{}) error {
+	if config.SecureAddress != "" && config.CertFile == "" && config.KeyFile == "" && !config.SelfSigned {
+		klog.InfoS("No serving certificate configured, HTTPS is disabled", "address", config.SecureAddress)
+		config.SecureAddress = ""
+	}
+	if config.SecureAddress == "" && config.InsecureAddress == "" {
+		return fmt.Errorf("neither a secure nor an insecure port is configured")
+	}
+	if config.SecureAddress != "" {
+		reloader, err := newCertReloader(config.CertFile, config.KeyFile, config.ClientCAFile)
+		if err != nil {
+			return err
+		}
+		go reloader.run(stopCh)
+		server := &http.Server{
+			Addr:      config.SecureAddress,
+			Handler:   handler,
+			TLSConfig: reloader.tlsConfig(),
+		}
+		klog.V(1).InfoS("Listening and serving HTTPS on", "address", config.SecureAddress, "mTLS", config.ClientCAFile != "")
+		go func() {
+			// the certificates are provided by the tls config
+			klog.Fatal(server.ListenAndServeTLS("", ""))
+		}()
+	}
+	if config.InsecureAddress != "" {
+		server := &http.Server{
+			Addr:    config.InsecureAddress,
+			Handler: handler,
+		}
+		klog.V(1).InfoS("Listening and serving on", "address", config.InsecureAddress)
+		go func() {
+			klog.Fatal(server.ListenAndServe())
+		}()
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ import './App.css';
 import Router from './routes';
 import { Helmet, HelmetProvider } from 'react-helmet-async';
 import { ConfigProvider, App as AntdApp } from 'antd';
+import { getBranding } from '@/utils/branding';
 import { QueryClient, QueryClientProvider } from '@tanstack/react-query';
 import AuthProvider from '@/components/auth';
 import { getAntdLocale } from '@/utils/i18n.tsx';
@@ -126,7 +127,7 @@ function App() {
       theme={{
         token: {
           // 舒适主题色 - 进一步降低亮度
-          colorPrimary: '#409eff',
+          colorPrimary: getBranding().theme.primary_color || '#409eff',
           colorSuccess: '#67c23a',
           colorWarning: '#e6a23c',
           colorError: '#f56c6c',
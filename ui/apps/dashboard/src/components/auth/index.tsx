@@ -14,68 +14,34 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-import {
-  createContext,
-  useContext,
-  ReactNode,
-  useMemo,
-  useState,
-  useCallback,
-} from 'react';
+import { createContext, useContext, ReactNode, useMemo } from 'react';
 import { Me } from '@/services/auth.ts';
-import { karmadaClient } from '@/services';
 import { useQuery } from '@tanstack/react-query';
 
+// the api keeps the token in a server-side session, the browser only holds its HttpOnly cookie
 const AuthContext = createContext<{
   authenticated: boolean;
-  token: string;
-  setToken: (v: string) => void;
+  refresh: () => Promise<unknown>;
 }>({
   authenticated: false,
-  token: '',
-  setToken: () => {},
+  refresh: async () => {},
 });
 
 const AuthProvider = ({ children }: { children: ReactNode }) => {
-  const [token, setToken_] = useState(localStorage.getItem('token'));
-  const setToken = useCallback((newToken: string) => {
-    localStorage.setItem('token', newToken);
-    setToken_(newToken);
-  }, []);
-  const { data, isLoading } = useQuery({
-    queryKey: ['Me', token],
+  const { data, isLoading, refetch } = useQuery({
+    queryKey: ['Me'],
     queryFn: async () => {
-      if (token) {
-        karmadaClient.defaults.headers.common[
-          'Authorization'
-        ] = `Bearer ${token}`;
-        // localStorage.setItem("token", token);
-        const ret = await Me();
-        return ret.data;
-      } else {
-        // delete karmadaClient.defaults.headers.common["Authorization"];
-        // localStorage.removeItem("token");
-        return {
-          authenticated: false,
-        };
-      }
+      const ret = await Me();
+      return ret.data ?? { authenticated: false };
     },
   });
-  const ctxValue = useMemo(() => {
-    if (data && token) {
-      return {
-        authenticated: !!data.authenticated,
-        token,
-        setToken,
-      };
-    } else {
-      return {
-        authenticated: false,
-        token: '',
-        setToken,
-      };
-    }
-  }, [data, token, setToken]);
+  const ctxValue = useMemo(
+    () => ({
+      authenticated: !!data?.authenticated,
+      refresh: refetch,
+    }),
+    [data, refetch],
+  );
   return (
     <AuthContext.Provider value={ctxValue}>
       {!isLoading && children}
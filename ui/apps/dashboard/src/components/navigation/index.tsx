@@ -25,6 +25,7 @@ import {
   getLangTitle,
 } from '@/utils/i18n';
 import { Dropdown } from 'antd';
+import { getBranding } from '@/utils/branding';
 
 export interface IUserInfo {
   id: number;
@@ -43,17 +44,26 @@ const Navigation: FC<INavigationProps> = (props) => {
   const {
     headerStyle = {},
     usePlaceholder = true,
-    brandText = 'Karmada Dashboard',
+    brandText = getBranding().product_name,
     userInfo,
   } = props;
+  const { logo_url, theme } = getBranding();
   return (
     <>
       <div className={styles.navbar}>
-        <div className={styles.header} style={headerStyle}>
+        <div
+          className={styles.header}
+          style={{
+            ...(theme.header_background_color
+              ? { background: theme.header_background_color }
+              : {}),
+            ...headerStyle,
+          }}
+        >
           <div className={styles.left}>
             <div className={styles.brand}>
               <div className={styles.logoWrap}>
-                <img className={styles.logo} src={karmadaLogo} />
+                <img className={styles.logo} src={logo_url || karmadaLogo} />
               </div>
               <div className={styles.text}>{brandText}</div>
             </div>
@@ -35,6 +35,8 @@ import {
   ThunderboltOutlined
 } from '@ant-design/icons';
 import '@/styles/tech-theme.css';
+import { useAuth } from '@/components/auth';
+import { Logout } from '@/services/auth.ts';
 
 const { Header, Sider, Content } = Layout;
 
@@ -45,6 +47,7 @@ interface TechLayoutProps {
 const TechLayout: React.FC<TechLayoutProps> = ({ children }) => {
   const [collapsed, setCollapsed] = useState(false);
   const navigate = useNavigate();
+  const { refresh } = useAuth();
   const location = useLocation();
 
   // 菜单项配置
@@ -144,8 +147,10 @@ const TechLayout: React.FC<TechLayoutProps> = ({ children }) => {
 
   const handleUserMenuClick = ({ key }: { key: string }) => {
     if (key === 'logout') {
-      // 处理退出登录
-      console.log('Logout');
+      // 退出登录，服务端会吊销会话并清除 cookie
+      void Logout()
+        .finally(refresh)
+        .then(() => navigate('/login'));
     } else if (key === 'profile') {
       // 处理个人设置
       console.log('Profile');
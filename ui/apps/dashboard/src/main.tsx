@@ -32,6 +32,8 @@ import yamlWorker from '@/utils/workaround-yaml.worker?worker';
 import enTexts from '../locales/en-US.json';
 import zhTexts from '../locales/zh-CN.json';
 import { initRoute } from '@/routes/route.tsx';
+import { applyFavicon } from '@/utils/branding';
+import { routerBase } from '@/services/base';
 
 dayjs.extend(duration);
 dayjs.extend(relativeTime);
@@ -47,6 +49,25 @@ window.MonacoEnvironment = {
 };
 loader.config({ monaco });
 
+// bundles served by karmada-dashboard-web from --i18n-dir extend the shipped translations
+async function loadServedBundle(lang: string) {
+  try {
+    const resp = await fetch(`${routerBase}i18n/${lang}.json`);
+    // the server falls back to other locales, only an exact match is added
+    if (resp.ok && resp.headers.get('Content-Language') === lang) {
+      i18nInstance.addResourceBundle(
+        lang,
+        'translation',
+        await resp.json(),
+        true,
+        true,
+      );
+    }
+  } catch (e) {
+    console.warn('failed to load i18n bundle', e);
+  }
+}
+
 i18nInstance
   .use(initReactI18next) // passes i18n down to react-i18next
   .init({
@@ -69,8 +90,10 @@ i18nInstance
       useSuspense: false,
     },
   })
-  .then(() => {
+  .then(async () => {
+    await loadServedBundle(getLang());
     initRoute();
+    applyFavicon();
     ReactDOM.createRoot(document.getElementById('root')!).render(
       <React.StrictMode>
         <App />
@@ -22,12 +22,15 @@ import { useState } from 'react';
 import { Login } from '@/services/auth.ts';
 import { useNavigate } from 'react-router-dom';
 import { useAuth } from '@/components/auth';
+import { getBranding } from '@/utils/branding';
 
 const LoginPage = () => {
   const [authToken, setAuthToken] = useState('');
   const [messageApi, contextHolder] = message.useMessage();
   const navigate = useNavigate();
-  const { setToken } = useAuth();
+  const { refresh } = useAuth();
+  const { product_name, logo_url, theme, login_banner, footer_links } =
+    getBranding();
   return (
     <div className={'h-screen w-screen  bg-[#FAFBFC]'}>
       <div className="h-full w-full flex justify-center items-center ">
@@ -38,11 +41,22 @@ const LoginPage = () => {
               className={
                 'bg-blue-500 text-white h-[56px] flex items-center px-[16px] text-xl rounded-t-[8px]'
               }
+              style={
+                theme.primary_color ? { background: theme.primary_color } : {}
+              }
             >
-              Karmada Dashboard
+              {logo_url && <img className={'h-[32px] mr-[12px]'} src={logo_url} />}
+              {product_name}
             </div>
           }
         >
+          {login_banner && (
+            <Alert
+              className={'mb-4 whitespace-pre-wrap'}
+              message={login_banner}
+              type="warning"
+            />
+          )}
           {/*<Alert message="参考文档生成jwt token" type="info"/>*/}
           <Alert
             message={
@@ -90,8 +104,9 @@ const LoginPage = () => {
                         '登录成功，即将跳转',
                       ),
                     );
-                    setTimeout(() => {
-                      setToken(authToken);
+                    setAuthToken('');
+                    setTimeout(async () => {
+                      await refresh();
                       navigate('/overview');
                     }, 1000);
                   } else {
@@ -117,6 +132,21 @@ const LoginPage = () => {
           </div>
         </Card>
       </div>
+      {footer_links && footer_links.length > 0 && (
+        <div className={'fixed bottom-4 w-full flex justify-center gap-6'}>
+          {footer_links.map((link) => (
+            <a
+              key={link.url}
+              href={link.url}
+              target="_blank"
+              rel="noopener noreferrer"
+              className={'text-gray-500'}
+            >
+              {link.text}
+            </a>
+          ))}
+        </div>
+      )}
       {contextHolder}
     </div>
   );
@@ -16,17 +16,28 @@ limitations under the License.
 
 import { IResponse, karmadaClient } from '@/services/base.ts';
 
+// Login starts a session, the api answers with an HttpOnly session cookie and keeps the token.
+// Only when sessions are disabled on the server the token is handed back, it is then kept in memory.
 export async function Login(token: string) {
   const resp = await karmadaClient.post<IResponse<{ token: string }>>(
     `/login`,
     { token },
     {
       headers: {
-        Authorization: `Bearer ${token}`,
         'Content-Type': 'application/json',
       },
     },
   );
+  if (resp.data.code === 200 && resp.data.data?.token) {
+    karmadaClient.defaults.headers.common['Authorization'] =
+      `Bearer ${resp.data.data.token}`;
+  }
+  return resp.data;
+}
+
+export async function Logout() {
+  delete karmadaClient.defaults.headers.common['Authorization'];
+  const resp = await karmadaClient.post<IResponse<string>>(`/logout`);
   return resp.data;
 }
 
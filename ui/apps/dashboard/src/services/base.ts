@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-import axios from 'axios';
+import axios, { AxiosRequestConfig, InternalAxiosRequestConfig } from 'axios';
 import _ from 'lodash';
 
 let pathPrefix = window.__path_prefix__ || '';
@@ -30,11 +30,106 @@ const baseURL: string = _.join([pathPrefix, 'api/v1'], '');
 export const karmadaClient = axios.create({
   baseURL,
 });
+// the api translates error codes into the language of the ui
+karmadaClient.interceptors.request.use((config) => {
+  config.headers['Accept-Language'] =
+    window.localStorage.getItem('i18next-lang') || 'en-US';
+  return config;
+});
+
+// mutating requests carry a csrf token bound to the session, it is fetched once and refreshed whenever
+// the session changes or the api rejects it.
+const csrfHeader = 'X-CSRF-TOKEN';
+const csrfErrorCode = 'MSG_CSRF_VALIDATION_ERROR';
+const mutatingMethods = ['post', 'put', 'patch', 'delete'];
+// these requests start a session, the api does not check their token
+const csrfExemptUrls = ['/login', '/login/oidc/callback'];
+// the token is bound to the session, it changes with these requests
+const sessionUrls = [...csrfExemptUrls, '/logout'];
+let csrfToken: Promise<string> | undefined;
+
+const getCSRFToken = () => {
+  if (!csrfToken) {
+    csrfToken = karmadaClient
+      .get<IResponse<{ token: string }>>('/csrftoken')
+      .then((resp) => resp.data.data.token)
+      .catch((e) => {
+        csrfToken = undefined;
+        throw e;
+      });
+  }
+  return csrfToken;
+};
+
+const matchesUrl = (urls: string[], url?: string) =>
+  urls.some((u) => url === u || url === u.slice(1));
+
+karmadaClient.interceptors.request.use(async (config) => {
+  const method = (config.method || 'get').toLowerCase();
+  if (mutatingMethods.includes(method) && !matchesUrl(csrfExemptUrls, config.url)) {
+    config.headers[csrfHeader] = await getCSRFToken();
+  }
+  return config;
+});
+
+const isCSRFError = (data?: IResponse) => data?.error?.code === csrfErrorCode;
+
+// a rejected token is refreshed and the request retried once
+const retryWithNewToken = (
+  config: InternalAxiosRequestConfig & { csrfRetried?: boolean },
+) => {
+  if (config.csrfRetried) {
+    return undefined;
+  }
+  csrfToken = undefined;
+  return karmadaClient.request({
+    ...config,
+    csrfRetried: true,
+  } as AxiosRequestConfig);
+};
+
+// v1 responses wrap errors with status 200, v2 responses use the http status
+karmadaClient.interceptors.response.use(
+  (response) => {
+    if (matchesUrl(sessionUrls, response.config.url)) {
+      csrfToken = undefined;
+    }
+    if (isCSRFError(response.data)) {
+      return retryWithNewToken(response.config) || response;
+    }
+    return response;
+  },
+  (error) => {
+    if (error.config && isCSRFError(error.response?.data)) {
+      const retry = retryWithNewToken(error.config);
+      if (retry) {
+        return retry;
+      }
+    }
+    return Promise.reject(error);
+  },
+);
+
+// structured error of failed requests, code is a stable MSG_*_ERROR code
+export interface ApiError {
+  code: string;
+  status: number;
+  reason?: string;
+  message: string;
+  params?: Record<string, string>;
+  causes?: {
+    type?: string;
+    field?: string;
+    message: string;
+  }[];
+}
 
 export interface IResponse<Data = {}> {
+  // 200 on success and 500 otherwise, the http status of the error is error.status
   code: number;
   message: string;
   data: Data;
+  error?: ApiError;
 }
 
 export interface DataSelectQuery {
@@ -43,6 +43,7 @@ interface DashboardConfig {
   docker_registries?: dockerRegistry[];
   chart_registries?: chartRegistry[];
   menu_configs?: menuConfig[];
+  feature_gates?: Record<string, boolean>;
 }
 
 export async function GetDashboardConfig() {
@@ -56,3 +57,12 @@ export async function SetDashboardConfig(cfg: Partial<DashboardConfig>) {
   const resp = await karmadaClient.post<IResponse<DashboardConfig>>(url, cfg);
   return resp.data;
 }
+
+// effective feature gates, features not set in the config are reported with their default
+export type FeatureGates = Record<string, boolean>;
+
+export async function GetFeatureGates() {
+  const url = '/config/feature-gates';
+  const resp = await karmadaClient.get<IResponse<FeatureGates>>(url);
+  return resp.data;
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+export interface FooterLink {
+  text: string;
+  url: string;
+}
+
+export interface Branding {
+  product_name: string;
+  logo_url?: string;
+  favicon_url?: string;
+  theme: {
+    primary_color?: string;
+    header_background_color?: string;
+  };
+  login_banner?: string;
+  footer_links?: FooterLink[];
+}
+
+const defaultBranding: Branding = {
+  product_name: 'Karmada Dashboard',
+  theme: {},
+};
+
+let branding: Branding | undefined;
+
+// the branding is rendered into index.html by karmada-dashboard-web, see {{Branding}}
+export function getBranding(): Branding {
+  if (branding) {
+    return branding;
+  }
+  branding = defaultBranding;
+  const element = document.getElementById('branding');
+  if (element?.textContent) {
+    try {
+      branding = {
+        ...defaultBranding,
+        ...(JSON.parse(element.textContent) as Partial<Branding>),
+      };
+    } catch (e) {
+      console.error('failed to parse branding', e);
+    }
+  }
+  return branding;
+}
+
+export function applyFavicon() {
+  const { favicon_url } = getBranding();
+  if (!favicon_url) {
+    return;
+  }
+  const link = document.querySelector<HTMLLinkElement>('link[rel="icon"]');
+  if (link) {
+    link.removeAttribute('type');
+    link.href = favicon_url;
+  }
+}
@@ -27,7 +27,10 @@ const replacePathPrefixPlugin = (): Plugin => {
     name: 'replace-path-prefix',
     transformIndexHtml: async (html) => {
       if (process.env.NODE_ENV !== 'production') {
-        return html.replace('{{PathPrefix}}', '');
+        return html
+          .replace('{{PathPrefix}}', '')
+          .replace('{{ProductName}}', 'Karmada Dashboard')
+          .replace('{{Branding}}', '{}');
       }
       return html;
     },